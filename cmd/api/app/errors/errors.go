@@ -41,10 +41,15 @@ var (
 	ClusterNotFoundInKarmada                   = NewHttpError(http.StatusNotFound, errmsg.ClusterNotFoundInKarmada)
 	ResourceNotFound                           = NewHttpError(http.StatusNotFound, errmsg.ResourceNotFound)
 	NamespaceNotFound                          = NewHttpError(http.StatusNotFound, errmsg.NamespaceNotFound)
+	SyncReportNotFound                         = NewHttpError(http.StatusNotFound, errmsg.SyncReportNotFound)
+	SyncJobNotFound                            = NewHttpError(http.StatusNotFound, errmsg.SyncJobNotFound)
+	ResourceRevisionNotFound                   = NewHttpError(http.StatusNotFound, errmsg.ResourceRevisionNotFound)
 	PolicyContainsUnauthorizedClusters         = NewHttpError(http.StatusForbidden, errmsg.PolicyContainsUnauthorizedClusters)
 	ClusterAlreadyRegistered                   = NewHttpError(http.StatusConflict, errmsg.ClusterAlreadyRegistered)
 	ClusterAlreadyRegisteredInKarmada          = NewHttpError(http.StatusConflict, errmsg.ClusterAlreadyRegisteredInKarmada)
 	ResourceAlreadyExists                      = NewHttpError(http.StatusConflict, errmsg.ResourceAlreadyExists)
+	ResourceApplyConflict                      = NewHttpError(http.StatusConflict, errmsg.ResourceApplyConflict)
+	ResourceNotPropagated                      = NewHttpError(http.StatusConflict, errmsg.ResourceNotPropagated)
 	ResourceUnprocessableEntity                = NewHttpError(http.StatusUnprocessableEntity, errmsg.ResourceUnprocessableEntity)
 	FailedToReadClusterInfo                    = NewHttpError(http.StatusInternalServerError, errmsg.FailedToReadClusterInfo)
 	FailedRequest                              = NewHttpError(http.StatusInternalServerError, errmsg.RequestFailed)
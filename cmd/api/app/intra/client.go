@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"github.com/karmada-io/dashboard/cmd/api/app/domain"
 	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/cmd/api/app/monitoring"
 	"io"
 	"log/slog"
 	"net/http"
@@ -29,7 +30,12 @@ const (
 	ClaimUserIdKey      = "userAuthId"
 )
 
-func ApiCall(target string, method, path string, body interface{}, model interface{}) error {
+func ApiCall(target string, method, path string, body interface{}, model interface{}) (err error) {
+	start := time.Now()
+	defer func() {
+		monitoring.ObserveInternalCall(monitoring.TargetCommonApi, time.Since(start).Seconds(), err)
+	}()
+
 	var reqBody io.Reader
 	client := http.Client{
 		Timeout: 5 * time.Second,
@@ -67,7 +73,7 @@ func ApiCall(target string, method, path string, body interface{}, model interfa
 	}
 
 	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		slog.Error("unexpected status", response.StatusCode, string(respBody))
+		slog.Error("unexpected status", "status", response.StatusCode, "body", string(respBody))
 		return apperrors.FailedRequest
 	}
 	if err = json.Unmarshal(respBody, model); err != nil {
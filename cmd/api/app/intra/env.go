@@ -26,6 +26,14 @@ type envConfigs struct {
 	DeleteFederatedClusterUrl  string   `mapstructure:"DeleteFederatedClusterUrl"`
 	IsSuperAdminCheckUrl       string   `mapstructure:"IsSuperAdminCheckUrl"`
 	FilterNamespaces           []string `mapstructure:"FilterNamespaces"`
+	// SyncAllowedResources limits the resources that can be synced from member clusters. Empty allows all.
+	SyncAllowedResources []string `mapstructure:"SyncAllowedResources"`
+	// SyncDeniedResources excludes resources from sync. It takes precedence over SyncAllowedResources.
+	SyncDeniedResources []string `mapstructure:"SyncDeniedResources"`
+	// SyncReportHistorySize is the number of sync reports kept per cluster
+	SyncReportHistorySize int `mapstructure:"SyncReportHistorySize"`
+	// SyncSanitizeProfileFile is a YAML file with sanitize profiles added to the built-in profiles of sync
+	SyncSanitizeProfileFile string `mapstructure:"SyncSanitizeProfileFile"`
 }
 
 func loadEnvVariables() (config *envConfigs) {
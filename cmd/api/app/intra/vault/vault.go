@@ -5,11 +5,17 @@ import (
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
 	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	"github.com/karmada-io/dashboard/cmd/api/app/monitoring"
 	"log/slog"
 	"time"
 )
 
-func read(path string) (map[string]interface{}, error) {
+func read(path string) (data map[string]interface{}, err error) {
+	start := time.Now()
+	defer func() {
+		monitoring.ObserveInternalCall(monitoring.TargetVault, time.Since(start).Seconds(), err)
+	}()
+
 	slog.Info("read value", "path", path)
 	ctx := context.Background()
 	//get vault client
@@ -1,8 +1,13 @@
 package metrics
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
+
 	v1api "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/nats-io/nats.go"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,6 +15,21 @@ import (
 	"k8s.io/klog/v2"
 )
 
+const (
+	reconnectWait = 2 * time.Second
+	rewatchWait   = 5 * time.Second
+)
+
+// ErrMetricsNotReady is returned when no cluster metric snapshot has been received yet.
+var ErrMetricsNotReady = errors.New("cluster metrics snapshot is not available yet")
+
+var (
+	// latestUsage holds the last decoded snapshot of the watched KV key.
+	latestUsage atomic.Pointer[ClusterUsage]
+	// lastUpdated holds the unix nano time of the last snapshot update.
+	lastUpdated atomic.Int64
+)
+
 type ClusterUsage struct {
 	Time                v1.Time  `json:"time"`
 	HostClusterStatus   Status   `json:"hostClusterStatus"`
@@ -26,62 +46,146 @@ type Status struct {
 	RequestUsage  v1api.Usage        `json:"requestUsage"`
 }
 
-// GetClustersRealTimeUsage retrieves the latest cluster metric snapshot from JetStream KV.
+// GetClustersRealTimeUsage returns a copy of the latest cluster metric snapshot received from JetStream KV.
 func GetClustersRealTimeUsage() (*ClusterUsage, error) {
-	raw, err := fetchClusterMetricsFromKV()
-	if err != nil {
-		klog.Errorf("Failed to fetch cluster metrics: %v", err)
-		return nil, err
+	usage := latestUsage.Load()
+	if usage == nil {
+		return nil, ErrMetricsNotReady
 	}
 
-	var usage *ClusterUsage
-	if err := json.Unmarshal(raw, &usage); err != nil {
-		klog.Errorf("Failed to unmarshal cluster metrics: %v", err)
-		return nil, err
-	}
-
-	return usage, nil
+	// callers are allowed to modify the result, so never hand out the shared snapshot
+	result := *usage
+	result.MemberClusterStatus = append([]Status(nil), usage.MemberClusterStatus...)
+	return &result, nil
 }
 
-// fetchClusterMetricsFromKV connects to JetStream and retrieves the cluster.metrics key from the KV bucket.
-func fetchClusterMetricsFromKV() ([]byte, error) {
-	nc, err := connectToNATS()
-	if err != nil {
-		return nil, err
+// LastUpdateTime returns the time the latest cluster metric snapshot was received.
+// The zero time is returned if no snapshot has been received yet.
+func LastUpdateTime() time.Time {
+	nanos := lastUpdated.Load()
+	if nanos == 0 {
+		return time.Time{}
 	}
-	defer func() {
-		if err := nc.Drain(); err != nil {
-			klog.Warningf("NATS drain error: %v", err)
+	return time.Unix(0, nanos)
+}
+
+// StartClusterUsageWatcher connects to NATS and keeps the latest cluster metric snapshot in memory
+// until ctx is done. The connection reconnects automatically and the KV watch is re-established
+// whenever it is interrupted.
+func StartClusterUsageWatcher(ctx context.Context) {
+	go func() {
+		nc, err := connectToNATS()
+		if err != nil {
+			klog.Errorf("Failed to start cluster metrics watcher: %v", err)
+			return
 		}
+		defer func() {
+			if err := nc.Drain(); err != nil {
+				klog.Warningf("NATS drain error: %v", err)
+			}
+		}()
+
+		keepWatching(ctx, func(ctx context.Context) error {
+			return watchClusterMetrics(ctx, nc)
+		}, rewatchWait)
 	}()
+}
 
+// keepWatching runs watch until ctx is done, and runs it again after wait whenever it returns.
+func keepWatching(ctx context.Context, watch func(context.Context) error, wait time.Duration) {
+	for {
+		if err := watch(ctx); err != nil {
+			klog.Warningf("Cluster metrics watch interrupted: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// watchClusterMetrics watches the cluster.metrics key of the KV bucket and stores every update
+// until ctx is done or the watch is closed.
+func watchClusterMetrics(ctx context.Context, nc *nats.Conn) error {
 	js, err := nc.JetStream()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+		return fmt.Errorf("failed to create JetStream context: %w", err)
 	}
 
 	kv, err := js.KeyValue(Env.NatsBucketName)
-	if err == nats.ErrBucketNotFound {
+	if errors.Is(err, nats.ErrBucketNotFound) {
 		klog.Infof("KV bucket %q is not found", Env.NatsBucketName)
-		return nil, err
+		return err
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to access KV bucket %q: %w", Env.NatsBucketName, err)
+		return fmt.Errorf("failed to access KV bucket %q: %w", Env.NatsBucketName, err)
 	}
 
-	entry, err := kv.Get(Env.NatsSubjectName)
+	watcher, err := kv.Watch(Env.NatsSubjectName, nats.IgnoreDeletes(), nats.Context(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get key %q: %w", Env.NatsSubjectName, err)
+		return fmt.Errorf("failed to watch key %q: %w", Env.NatsSubjectName, err)
+	}
+	defer func() {
+		if err := watcher.Stop(); err != nil && !errors.Is(err, nats.ErrConnectionClosed) {
+			klog.Warningf("NATS watcher stop error: %v", err)
+		}
+	}()
+
+	klog.Infof("Watching cluster metrics key %q in KV bucket %q", Env.NatsSubjectName, Env.NatsBucketName)
+	return consumeClusterMetrics(ctx, watcher)
+}
+
+// consumeClusterMetrics stores every update of the watcher until ctx is done or the watcher is closed.
+func consumeClusterMetrics(ctx context.Context, watcher nats.KeyWatcher) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return fmt.Errorf("watcher for key %q closed", Env.NatsSubjectName)
+			}
+			// a nil entry marks the end of the initial values
+			if entry == nil {
+				continue
+			}
+			if err := storeClusterUsage(entry.Value()); err != nil {
+				klog.Errorf("Failed to unmarshal cluster metrics: %v", err)
+			}
+		}
+	}
+}
+
+// storeClusterUsage decodes raw and replaces the latest snapshot with it.
+func storeClusterUsage(raw []byte) error {
+	var usage ClusterUsage
+	if err := json.Unmarshal(raw, &usage); err != nil {
+		return err
 	}
 
-	klog.Infof("Retrieved cluster metric value: %s", string(entry.Value()))
-	return entry.Value(), nil
+	klog.V(4).Infof("Retrieved cluster metric value: %s", string(raw))
+	latestUsage.Store(&usage)
+	lastUpdated.Store(time.Now().UnixNano())
+	usageHistory.record(&usage)
+	return nil
 }
 
-// connectToNATS establishes a connection to the NATS server using basic authentication.
+// connectToNATS establishes a long-lived connection to the NATS server using basic authentication.
 func connectToNATS() (*nats.Conn, error) {
 	opts := []nats.Option{
 		nats.UserInfo(Env.NatsUsername, Env.NatsPassword),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(reconnectWait),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				klog.Warningf("NATS disconnected: %v", err)
+			}
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			klog.Infof("NATS reconnected to %s", nc.ConnectedUrl())
+		}),
 	}
 
 	nc, err := nats.Connect(Env.NatsUrl, opts...)
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// fakeEntry is a KV entry with a value only.
+type fakeEntry struct {
+	nats.KeyValueEntry
+	value []byte
+}
+
+func (e fakeEntry) Value() []byte { return e.value }
+
+// fakeWatcher hands out the updates of its channel.
+type fakeWatcher struct {
+	nats.KeyWatcher
+	updates chan nats.KeyValueEntry
+}
+
+func (w *fakeWatcher) Updates() <-chan nats.KeyValueEntry { return w.updates }
+
+func resetClusterUsage() {
+	latestUsage.Store(nil)
+	lastUpdated.Store(0)
+	usageHistory = newUsageHistoryStore()
+}
+
+func TestStoreClusterUsage(t *testing.T) {
+	resetClusterUsage()
+	if _, err := GetClustersRealTimeUsage(); err != ErrMetricsNotReady {
+		t.Fatalf("GetClustersRealTimeUsage() == %v, expected ErrMetricsNotReady", err)
+	}
+	if !LastUpdateTime().IsZero() {
+		t.Errorf("LastUpdateTime() == %v, expected zero", LastUpdateTime())
+	}
+
+	if err := storeClusterUsage([]byte(`{"memberClusterStatus":[{"name":"member1"}]}`)); err != nil {
+		t.Fatalf("storeClusterUsage() failed: %v", err)
+	}
+	usage, err := GetClustersRealTimeUsage()
+	if err != nil {
+		t.Fatalf("GetClustersRealTimeUsage() failed: %v", err)
+	}
+	// the result is a copy of the snapshot
+	usage.MemberClusterStatus[0].Name = "changed"
+	if usage, _ := GetClustersRealTimeUsage(); usage.MemberClusterStatus[0].Name != "member1" {
+		t.Errorf("GetClustersRealTimeUsage() == %#v, expected the snapshot unchanged", usage.MemberClusterStatus)
+	}
+	if LastUpdateTime().IsZero() {
+		t.Error("LastUpdateTime() is zero, expected the time of the snapshot")
+	}
+
+	// an invalid value keeps the previous snapshot
+	if err := storeClusterUsage([]byte(`{`)); err == nil {
+		t.Error("storeClusterUsage() succeeded, expected an error")
+	}
+	if err := storeClusterUsage([]byte(`{"memberClusterStatus":[{"name":"member2"}]}`)); err != nil {
+		t.Fatalf("storeClusterUsage() failed: %v", err)
+	}
+	if usage, _ := GetClustersRealTimeUsage(); usage.MemberClusterStatus[0].Name != "member2" {
+		t.Errorf("GetClustersRealTimeUsage() == %#v, expected the new snapshot", usage.MemberClusterStatus)
+	}
+}
+
+func TestConsumeClusterMetrics(t *testing.T) {
+	resetClusterUsage()
+	watcher := &fakeWatcher{updates: make(chan nats.KeyValueEntry, 3)}
+	watcher.updates <- fakeEntry{value: []byte(`{"memberClusterStatus":[{"name":"member1"}]}`)}
+	// the end of the initial values
+	watcher.updates <- nil
+	watcher.updates <- fakeEntry{value: []byte(`not json`)}
+	close(watcher.updates)
+
+	if err := consumeClusterMetrics(context.Background(), watcher); err == nil {
+		t.Error("consumeClusterMetrics() succeeded, expected an error for the closed watcher")
+	}
+	if usage, err := GetClustersRealTimeUsage(); err != nil || usage.MemberClusterStatus[0].Name != "member1" {
+		t.Errorf("GetClustersRealTimeUsage() == %#v, %v expected the snapshot of member1", usage, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := consumeClusterMetrics(ctx, &fakeWatcher{updates: make(chan nats.KeyValueEntry)}); err != nil {
+		t.Errorf("consumeClusterMetrics() == %v, expected nil once ctx is done", err)
+	}
+}
+
+func TestKeepWatching(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var watches atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		keepWatching(ctx, func(context.Context) error {
+			if watches.Add(1) == 3 {
+				cancel()
+				return nil
+			}
+			return nats.ErrConnectionClosed
+		}, time.Millisecond)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("keepWatching() did not return once ctx is done")
+	}
+	if watches.Load() != 3 {
+		t.Errorf("keepWatching() watched %d times, expected 3", watches.Load())
+	}
+}
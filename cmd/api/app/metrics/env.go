@@ -18,6 +18,10 @@ type envConfigs struct {
 	NatsUrl         string `mapstructure:"NatsUrl"`
 	NatsBucketName  string `mapstructure:"NatsBucketName"`
 	NatsSubjectName string `mapstructure:"NatsSubjectName"`
+	// UsageHistorySize is the number of snapshots kept per cluster for the usage time series
+	UsageHistorySize int `mapstructure:"UsageHistorySize"`
+	// StaleThreshold is the maximum age (e.g. 2m) of a snapshot before its usage is marked stale
+	StaleThreshold string `mapstructure:"StaleThreshold"`
 }
 
 func loadEnvVariables() (config *envConfigs) {
@@ -0,0 +1,209 @@
+package metrics
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	v1api "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultUsageHistorySize is the number of snapshots kept per cluster when UsageHistorySize is not configured.
+const defaultUsageHistorySize = 1440
+
+var usageHistory = newUsageHistoryStore()
+
+// UsagePoint is a single cluster usage sample taken from a metric snapshot.
+type UsagePoint struct {
+	Time          time.Time
+	RealTimeUsage v1api.Usage
+	RequestUsage  v1api.Usage
+}
+
+// usageRing is a fixed-size ring buffer of usage points ordered by insertion time.
+type usageRing struct {
+	points []UsagePoint
+	next   int
+	full   bool
+}
+
+func newUsageRing(size int) *usageRing {
+	return &usageRing{points: make([]UsagePoint, size)}
+}
+
+func (r *usageRing) add(p UsagePoint) {
+	r.points[r.next] = p
+	r.next = (r.next + 1) % len(r.points)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+func (r *usageRing) last() (UsagePoint, bool) {
+	if !r.full && r.next == 0 {
+		return UsagePoint{}, false
+	}
+	return r.points[(r.next-1+len(r.points))%len(r.points)], true
+}
+
+// between returns the points within [from, to] in chronological order.
+func (r *usageRing) between(from, to time.Time) []UsagePoint {
+	var ordered []UsagePoint
+	if r.full {
+		ordered = append(ordered, r.points[r.next:]...)
+	}
+	ordered = append(ordered, r.points[:r.next]...)
+
+	result := make([]UsagePoint, 0)
+	for _, p := range ordered {
+		if p.Time.Before(from) || p.Time.After(to) {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+type usageHistoryStore struct {
+	mu       sync.RWMutex
+	clusters map[string]*usageRing
+}
+
+func newUsageHistoryStore() *usageHistoryStore {
+	return &usageHistoryStore{clusters: make(map[string]*usageRing)}
+}
+
+// record appends the host and member cluster usage of the snapshot to each cluster's time series.
+func (s *usageHistoryStore) record(usage *ClusterUsage) {
+	at := usage.Time.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(usage.HostClusterStatus, at)
+	for _, status := range usage.MemberClusterStatus {
+		s.add(status, at)
+	}
+}
+
+func (s *usageHistoryStore) add(status Status, at time.Time) {
+	if status.ClusterId == "" {
+		return
+	}
+	ring, ok := s.clusters[status.ClusterId]
+	if !ok {
+		ring = newUsageRing(usageHistorySize())
+		s.clusters[status.ClusterId] = ring
+	}
+	// the same snapshot is delivered again whenever the watch is re-established
+	if last, ok := ring.last(); ok && !at.After(last.Time) {
+		return
+	}
+	ring.add(UsagePoint{
+		Time:          at,
+		RealTimeUsage: status.RealTimeUsage,
+		RequestUsage:  status.RequestUsage,
+	})
+}
+
+func (s *usageHistoryStore) between(clusterID string, from, to time.Time) ([]UsagePoint, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ring, ok := s.clusters[clusterID]
+	if !ok {
+		return nil, false
+	}
+	return ring.between(from, to), true
+}
+
+func usageHistorySize() int {
+	if Env != nil && Env.UsageHistorySize > 0 {
+		return Env.UsageHistorySize
+	}
+	return defaultUsageHistorySize
+}
+
+// GetClusterUsageHistory returns the usage samples of the cluster within [from, to],
+// downsampled into windows of step with min/avg/max aggregates per window.
+// Windows without samples are omitted.
+func GetClusterUsageHistory(clusterID string, from, to time.Time, step time.Duration) []v1api.ClusterUsageSample {
+	points, _ := usageHistory.between(clusterID, from, to)
+	return downsample(points, from, step)
+}
+
+// downsample groups chronologically ordered points into windows of step starting at from.
+func downsample(points []UsagePoint, from time.Time, step time.Duration) []v1api.ClusterUsageSample {
+	samples := make([]v1api.ClusterUsageSample, 0)
+	var window []UsagePoint
+	var windowStart time.Time
+
+	flush := func() {
+		if len(window) == 0 {
+			return
+		}
+		samples = append(samples, v1api.ClusterUsageSample{
+			Time:  v1.NewTime(windowStart),
+			Count: len(window),
+			RealTimeUsage: summarize(window, func(p UsagePoint) v1api.Usage {
+				return p.RealTimeUsage
+			}),
+			RequestUsage: summarize(window, func(p UsagePoint) v1api.Usage {
+				return p.RequestUsage
+			}),
+		})
+		window = window[:0]
+	}
+
+	for _, p := range points {
+		start := from.Add(p.Time.Sub(from) / step * step)
+		if !start.Equal(windowStart) {
+			flush()
+			windowStart = start
+		}
+		window = append(window, p)
+	}
+	flush()
+	return samples
+}
+
+func summarize(points []UsagePoint, usageOf func(UsagePoint) v1api.Usage) v1api.UsageSummary {
+	cpu := make([]float64, 0, len(points))
+	memory := make([]float64, 0, len(points))
+	for _, p := range points {
+		usage := usageOf(p)
+		cpu = append(cpu, usage.CPU)
+		memory = append(memory, usage.Memory)
+	}
+	return v1api.UsageSummary{
+		CPU:    aggregate(cpu),
+		Memory: aggregate(memory),
+	}
+}
+
+// aggregate returns min/avg/max of values, ignoring negative values which mark missing usage.
+// All fields are -1 if no value is available, matching v1api.InitUsage.
+func aggregate(values []float64) v1api.UsageStat {
+	stat := v1api.UsageStat{Min: -1, Avg: -1, Max: -1}
+	var sum float64
+	var n int
+	for _, v := range values {
+		if v < 0 {
+			continue
+		}
+		if n == 0 || v < stat.Min {
+			stat.Min = v
+		}
+		if n == 0 || v > stat.Max {
+			stat.Max = v
+		}
+		sum += v
+		n++
+	}
+	if n > 0 {
+		stat.Avg = math.Round(sum/float64(n)*100) / 100
+	}
+	return stat
+}
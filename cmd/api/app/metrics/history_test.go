@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	v1api "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var historyStart = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+func pointAt(minutes int, cpu float64) UsagePoint {
+	return UsagePoint{
+		Time:          historyStart.Add(time.Duration(minutes) * time.Minute),
+		RealTimeUsage: v1api.Usage{CPU: cpu, Memory: -1},
+		RequestUsage:  v1api.Usage{CPU: -1, Memory: -1},
+	}
+}
+
+func minutesOf(points []UsagePoint) []int {
+	minutes := make([]int, 0, len(points))
+	for _, p := range points {
+		minutes = append(minutes, int(p.Time.Sub(historyStart)/time.Minute))
+	}
+	return minutes
+}
+
+func TestUsageRing(t *testing.T) {
+	cases := []struct {
+		size     int
+		added    []int
+		from, to int
+		expected []int
+		last     int
+	}{
+		{3, nil, 0, 10, []int{}, -1},
+		{3, []int{0, 1}, 0, 10, []int{0, 1}, 1},
+		// the oldest points are overwritten
+		{3, []int{0, 1, 2, 3, 4}, 0, 10, []int{2, 3, 4}, 4},
+		{3, []int{0, 1, 2}, 0, 10, []int{0, 1, 2}, 2},
+		{3, []int{0, 1, 2, 3, 4}, 3, 3, []int{3}, 4},
+	}
+	for _, c := range cases {
+		ring := newUsageRing(c.size)
+		for _, minute := range c.added {
+			ring.add(pointAt(minute, 1))
+		}
+		actual := minutesOf(ring.between(historyStart.Add(time.Duration(c.from)*time.Minute), historyStart.Add(time.Duration(c.to)*time.Minute)))
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("between(%d, %d) after %v == %v, expected %v", c.from, c.to, c.added, actual, c.expected)
+		}
+		last, ok := ring.last()
+		if ok != (c.last >= 0) || (ok && minutesOf([]UsagePoint{last})[0] != c.last) {
+			t.Errorf("last() after %v == %v, %v expected %d", c.added, last.Time, ok, c.last)
+		}
+	}
+}
+
+func TestUsageHistoryStoreRecord(t *testing.T) {
+	store := newUsageHistoryStore()
+	snapshot := func(minute int) *ClusterUsage {
+		return &ClusterUsage{
+			Time:                v1.NewTime(historyStart.Add(time.Duration(minute) * time.Minute)),
+			HostClusterStatus:   Status{ClusterId: "host"},
+			MemberClusterStatus: []Status{{ClusterId: "member1"}, {Name: "no-id"}},
+		}
+	}
+	store.record(snapshot(0))
+	store.record(snapshot(1))
+	// a snapshot delivered again on a new watch is recorded once
+	store.record(snapshot(1))
+
+	points, ok := store.between("member1", historyStart, historyStart.Add(time.Hour))
+	if !ok || !reflect.DeepEqual(minutesOf(points), []int{0, 1}) {
+		t.Errorf("between(member1) == %v, %v expected [0 1]", minutesOf(points), ok)
+	}
+	if _, ok := store.between("no-id", historyStart, historyStart.Add(time.Hour)); ok {
+		t.Error("between(no-id) found a cluster without id")
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	stat := func(min, avg, max float64) v1api.UsageSummary {
+		return v1api.UsageSummary{CPU: v1api.UsageStat{Min: min, Avg: avg, Max: max}, Memory: v1api.UsageStat{Min: -1, Avg: -1, Max: -1}}
+	}
+	missing := stat(-1, -1, -1)
+	cases := []struct {
+		points   []UsagePoint
+		step     time.Duration
+		expected []v1api.ClusterUsageSample
+	}{
+		{nil, 5 * time.Minute, []v1api.ClusterUsageSample{}},
+		{
+			[]UsagePoint{pointAt(0, 10), pointAt(2, 20), pointAt(4, 30), pointAt(12, 40)},
+			5 * time.Minute,
+			// the window from 5 to 10 has no points and is omitted
+			[]v1api.ClusterUsageSample{
+				{Time: v1.NewTime(historyStart), Count: 3, RealTimeUsage: stat(10, 20, 30), RequestUsage: missing},
+				{Time: v1.NewTime(historyStart.Add(10 * time.Minute)), Count: 1, RealTimeUsage: stat(40, 40, 40), RequestUsage: missing},
+			},
+		},
+		{
+			[]UsagePoint{pointAt(1, 10), pointAt(1, -1)},
+			time.Minute,
+			[]v1api.ClusterUsageSample{
+				{Time: v1.NewTime(historyStart.Add(time.Minute)), Count: 2, RealTimeUsage: stat(10, 10, 10), RequestUsage: missing},
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := downsample(c.points, historyStart, c.step)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("downsample(%v, %v) == \n%#v\nexpected \n%#v\n", minutesOf(c.points), c.step, actual, c.expected)
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	cases := []struct {
+		values   []float64
+		expected v1api.UsageStat
+	}{
+		{nil, v1api.UsageStat{Min: -1, Avg: -1, Max: -1}},
+		{[]float64{-1, -1}, v1api.UsageStat{Min: -1, Avg: -1, Max: -1}},
+		{[]float64{0}, v1api.UsageStat{Min: 0, Avg: 0, Max: 0}},
+		{[]float64{30, 10, -1, 20}, v1api.UsageStat{Min: 10, Avg: 20, Max: 30}},
+		{[]float64{1, 2, 2}, v1api.UsageStat{Min: 1, Avg: 1.67, Max: 2}},
+	}
+	for _, c := range cases {
+		actual := aggregate(c.values)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("aggregate(%v) == %#v, expected %#v", c.values, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	v1api "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// defaultStaleThreshold is used when StaleThreshold is not configured or invalid.
+const defaultStaleThreshold = 2 * time.Minute
+
+var (
+	staleThresholdOnce sync.Once
+	staleThreshold     time.Duration
+)
+
+// StaleThreshold returns the maximum age of a snapshot before its usage is considered stale.
+func StaleThreshold() time.Duration {
+	staleThresholdOnce.Do(func() {
+		staleThreshold = defaultStaleThreshold
+		if Env != nil {
+			staleThreshold = parseStaleThreshold(Env.StaleThreshold)
+		}
+	})
+	return staleThreshold
+}
+
+// parseStaleThreshold returns the configured threshold, or defaultStaleThreshold if it is empty or invalid.
+func parseStaleThreshold(value string) time.Duration {
+	if value == "" {
+		return defaultStaleThreshold
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		klog.Warningf("Invalid StaleThreshold %q, using default %s", value, defaultStaleThreshold)
+		return defaultStaleThreshold
+	}
+	return d
+}
+
+// IsStale reports whether a value collected at collectedAt is older than the staleness threshold.
+// A zero time is always stale.
+func IsStale(collectedAt time.Time) bool {
+	return isStaleAt(collectedAt, time.Now(), StaleThreshold())
+}
+
+func isStaleAt(collectedAt, now time.Time, threshold time.Duration) bool {
+	return collectedAt.IsZero() || now.Sub(collectedAt) > threshold
+}
+
+// StampUsage returns usage marked with the time it was collected at and whether it is stale. The collection
+// time is left empty if collectedAt is zero.
+func StampUsage(usage v1api.Usage, collectedAt time.Time) v1api.Usage {
+	usage.CollectedAt = nil
+	if !collectedAt.IsZero() {
+		t := v1.NewTime(collectedAt)
+		usage.CollectedAt = &t
+	}
+	usage.Stale = IsStale(collectedAt)
+	return usage
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	v1api "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+)
+
+func TestParseStaleThreshold(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", defaultStaleThreshold},
+		{"30s", 30 * time.Second},
+		{"5m", 5 * time.Minute},
+		{"two minutes", defaultStaleThreshold},
+		{"0s", defaultStaleThreshold},
+		{"-1m", defaultStaleThreshold},
+	}
+	for _, c := range cases {
+		if actual := parseStaleThreshold(c.value); actual != c.expected {
+			t.Errorf("parseStaleThreshold(%q) == %s, expected %s", c.value, actual, c.expected)
+		}
+	}
+}
+
+func TestIsStaleAt(t *testing.T) {
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	cases := []struct {
+		collectedAt time.Time
+		expected    bool
+	}{
+		{time.Time{}, true},
+		{now, false},
+		{now.Add(-time.Minute), false},
+		// a value exactly as old as the threshold is not stale yet
+		{now.Add(-2 * time.Minute), false},
+		{now.Add(-2*time.Minute - time.Second), true},
+	}
+	for _, c := range cases {
+		if actual := isStaleAt(c.collectedAt, now, 2*time.Minute); actual != c.expected {
+			t.Errorf("isStaleAt(%s) == %v, expected %v", c.collectedAt, actual, c.expected)
+		}
+	}
+}
+
+func TestStampUsage(t *testing.T) {
+	fresh := StampUsage(v1api.Usage{CPU: 10, Memory: 20}, time.Now())
+	if fresh.Stale || fresh.Missing || fresh.CollectedAt == nil {
+		t.Errorf("StampUsage(now) == %#v, expected a fresh usage with collectedAt", fresh)
+	}
+	old := StampUsage(v1api.Usage{CPU: 10, Memory: 20}, time.Now().Add(-time.Hour))
+	if !old.Stale || old.CollectedAt == nil {
+		t.Errorf("StampUsage(an hour ago) == %#v, expected a stale usage with collectedAt", old)
+	}
+	missing := StampUsage(v1api.InitUsage(), time.Time{})
+	if !missing.Stale || !missing.Missing || missing.CollectedAt != nil {
+		t.Errorf("StampUsage(InitUsage(), zero) == %#v, expected a stale missing usage without collectedAt", missing)
+	}
+}
@@ -0,0 +1,113 @@
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/metrics"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const clusterListTimeout = 5 * time.Second
+
+var (
+	clusterReadyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "ready"),
+		"Whether the Karmada member cluster is Ready (1) or not (0).",
+		[]string{"cluster"}, nil,
+	)
+	clusterUsageDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "usage_percent"),
+		"Cluster resource usage in percent from the federation collector, by type (realtime, request) and resource (cpu, memory).",
+		[]string{"cluster", "cluster_id", "role", "type", "resource"}, nil,
+	)
+	clusterMetricsAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "metrics_age_seconds"),
+		"Seconds since the last cluster metrics snapshot was received.",
+		nil, nil,
+	)
+)
+
+// clusterCollector reports the Ready state of the Karmada clusters and the usage
+// of the latest metrics.ClusterUsage snapshot at scrape time.
+type clusterCollector struct{}
+
+func newClusterCollector() prometheus.Collector {
+	return clusterCollector{}
+}
+
+func (clusterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- clusterReadyDesc
+	ch <- clusterUsageDesc
+	ch <- clusterMetricsAgeDesc
+}
+
+func (clusterCollector) Collect(ch chan<- prometheus.Metric) {
+	collectClusterReady(ch)
+	collectClusterUsage(ch)
+}
+
+func collectClusterReady(ch chan<- prometheus.Metric) {
+	karmadaClient := client.InClusterKarmadaClient()
+	if karmadaClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterListTimeout)
+	defer cancel()
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		klog.Warningf("Failed to list clusters for metrics: %v", err)
+		return
+	}
+	for _, c := range clusters.Items {
+		ready := 0.0
+		for _, condition := range c.Status.Conditions {
+			if condition.Type == v1alpha1.ClusterConditionReady && condition.Status == metav1.ConditionTrue {
+				ready = 1
+			}
+		}
+		ch <- prometheus.MustNewConstMetric(clusterReadyDesc, prometheus.GaugeValue, ready, c.Name)
+	}
+}
+
+func collectClusterUsage(ch chan<- prometheus.Metric) {
+	lastUpdateTime := metrics.LastUpdateTime()
+	if lastUpdateTime.IsZero() {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(clusterMetricsAgeDesc, prometheus.GaugeValue, time.Since(lastUpdateTime).Seconds())
+
+	usage, err := metrics.GetClustersRealTimeUsage()
+	if err != nil {
+		return
+	}
+	collectStatusUsage(ch, usage.HostClusterStatus, "host")
+	for _, status := range usage.MemberClusterStatus {
+		collectStatusUsage(ch, status, "member")
+	}
+}
+
+func collectStatusUsage(ch chan<- prometheus.Metric, status metrics.Status, role string) {
+	values := []struct {
+		usageType string
+		resource  string
+		value     float64
+	}{
+		{"realtime", "cpu", status.RealTimeUsage.CPU},
+		{"realtime", "memory", status.RealTimeUsage.Memory},
+		{"request", "cpu", status.RequestUsage.CPU},
+		{"request", "memory", status.RequestUsage.Memory},
+	}
+	for _, v := range values {
+		// negative values mark missing usage
+		if v.value < 0 {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(clusterUsageDesc, prometheus.GaugeValue, v.value,
+			status.Name, status.ClusterId, role, v.usageType, v.resource)
+	}
+}
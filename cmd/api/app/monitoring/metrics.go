@@ -0,0 +1,130 @@
+package monitoring
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "federation_api"
+
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+
+	TargetCommonApi = "common_api"
+	TargetVault     = "vault"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	kubeClientRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "kube_client",
+		Name:      "request_duration_seconds",
+		Help:      "Kubernetes API client request latency by target (karmada, host, member) and verb.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"target", "verb"})
+
+	kubeClientRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kube_client",
+		Name:      "requests_total",
+		Help:      "Number of Kubernetes API client requests by target (karmada, host, member), method and status code, the requests to member clusters through the Karmada proxy are counted as karmada as only the host is known.",
+	}, []string{"target", "method", "code"})
+
+	internalCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "internal",
+		Name:      "calls_total",
+		Help:      "Number of calls to internal services (common_api, vault) by result.",
+	}, []string{"target", "result"})
+
+	internalCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "internal",
+		Name:      "call_duration_seconds",
+		Help:      "Latency of calls to internal services (common_api, vault) by result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"target", "result"})
+
+	clusterRegistrationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cluster",
+		Name:      "registrations_total",
+		Help:      "Number of member cluster registrations by result.",
+	}, []string{"result"})
+
+	syncResourcesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sync",
+		Name:      "resources_total",
+		Help:      "Number of resources synced from member clusters into Karmada by result.",
+	}, []string{"result"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		kubeClientRequestDuration,
+		kubeClientRequestsTotal,
+		internalCallsTotal,
+		internalCallDuration,
+		clusterRegistrationsTotal,
+		syncResourcesTotal,
+		newClusterCollector(),
+	)
+	registerKubeClientMetrics()
+}
+
+// Handler returns the http handler serving the Prometheus exposition of the registry.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ResultOf returns ResultSuccess if err is nil and ResultFailure otherwise.
+func ResultOf(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+	return ResultSuccess
+}
+
+// ObserveInternalCall records a call to an internal service (common_api, vault).
+func ObserveInternalCall(target string, seconds float64, err error) {
+	result := ResultOf(err)
+	internalCallsTotal.WithLabelValues(target, result).Inc()
+	internalCallDuration.WithLabelValues(target, result).Observe(seconds)
+}
+
+// ObserveClusterRegistration records the result of a member cluster registration.
+func ObserveClusterRegistration(err error) {
+	clusterRegistrationsTotal.WithLabelValues(ResultOf(err)).Inc()
+}
+
+// AddSyncResources records the number of synced resources by result.
+func AddSyncResources(success, failure int) {
+	syncResourcesTotal.WithLabelValues(ResultSuccess).Add(float64(success))
+	syncResourcesTotal.WithLabelValues(ResultFailure).Add(float64(failure))
+}
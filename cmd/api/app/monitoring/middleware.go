@@ -0,0 +1,28 @@
+package monitoring
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute is used as route label for requests that did not match any route,
+// so that unknown paths do not create new series.
+const unmatchedRoute = "unmatched"
+
+// HTTPMetricsMiddleware records request count and latency per gin route and status code.
+func HTTPMetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		code := strconv.Itoa(c.Writer.Status())
+		httpRequestsTotal.WithLabelValues(c.Request.Method, route, code).Inc()
+		httpRequestDuration.WithLabelValues(c.Request.Method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
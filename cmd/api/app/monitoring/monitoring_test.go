@@ -0,0 +1,126 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func metricOf(t *testing.T, collector interface{}) *dto.Metric {
+	t.Helper()
+	var m dto.Metric
+	if err := collector.(prometheus.Metric).Write(&m); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	return &m
+}
+
+func TestTargetOf(t *testing.T) {
+	resolved := 0
+	hosts := &apiServerHosts{resolve: func() (string, string, bool) {
+		resolved++
+		// the clients are initialized on the second request
+		return "karmada:5443", "kubernetes:443", resolved > 1
+	}}
+	if target := hosts.targetOf("karmada:5443"); target != targetMember {
+		t.Errorf("targetOf() == %s before the clients are initialized, expected %s", target, targetMember)
+	}
+	cases := []struct {
+		host     string
+		expected string
+	}{
+		{"karmada:5443", targetKarmada},
+		{"kubernetes:443", targetHost},
+		{"10.0.0.1:6443", targetMember},
+	}
+	for _, c := range cases {
+		if actual := hosts.targetOf(c.host); actual != c.expected {
+			t.Errorf("targetOf(%s) == %s, expected %s", c.host, actual, c.expected)
+		}
+	}
+	if resolved != 2 {
+		t.Errorf("resolve() called %d times, expected the hosts resolved once", resolved)
+	}
+}
+
+func TestKubeClientMetricsTarget(t *testing.T) {
+	previous := apiServers
+	defer func() { apiServers = previous }()
+	apiServers = &apiServerHosts{resolve: func() (string, string, bool) {
+		return "karmada:5443", "kubernetes:443", true
+	}}
+
+	// the latency of a member cluster reached through the karmada proxy is classified by path, its result by host
+	u := url.URL{Scheme: "https", Host: "karmada:5443", Path: "/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy/api/v1/pods"}
+	requestLatency{}.Observe(context.TODO(), "GET", u, 10*time.Millisecond)
+	requestResult{}.Increment(context.TODO(), "200", "GET", u.Host)
+	karmada := url.URL{Scheme: "https", Host: "karmada:5443", Path: "/apis/cluster.karmada.io/v1alpha1/clusters/member1"}
+	requestLatency{}.Observe(context.TODO(), "PUT", karmada, 10*time.Millisecond)
+
+	if count := metricOf(t, kubeClientRequestDuration.WithLabelValues(targetMember, "GET")).GetHistogram().GetSampleCount(); count != 1 {
+		t.Errorf("request_duration_seconds{target=member} count == %d, expected 1", count)
+	}
+	if count := metricOf(t, kubeClientRequestDuration.WithLabelValues(targetKarmada, "PUT")).GetHistogram().GetSampleCount(); count != 1 {
+		t.Errorf("request_duration_seconds{target=karmada} count == %d, expected 1", count)
+	}
+	if value := metricOf(t, kubeClientRequestsTotal.WithLabelValues(targetKarmada, "GET", "200")).GetCounter().GetValue(); value != 1 {
+		t.Errorf("requests_total{target=karmada} == %v, expected 1", value)
+	}
+}
+
+func TestIsClusterProxyPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected bool
+	}{
+		{"/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy/", true},
+		{"/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy/apis/apps/v1/deployments", true},
+		{"/apis/cluster.karmada.io/v1alpha1/clusters/member1", false},
+		{"/apis/cluster.karmada.io/v1alpha1/clusters/member1/status", false},
+		{"/apis/cluster.karmada.io/v1alpha1/clusters//proxy/", false},
+		{"/api/v1/namespaces/default/pods", false},
+	}
+	for _, c := range cases {
+		if actual := isClusterProxyPath(c.path); actual != c.expected {
+			t.Errorf("isClusterProxyPath(%s) == %t, expected %t", c.path, actual, c.expected)
+		}
+	}
+}
+
+func TestHTTPMetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(HTTPMetricsMiddleware())
+	router.GET("/api/v1/cluster/:name", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	for _, path := range []string{"/api/v1/cluster/member1", "/api/v1/cluster/member2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	if value := metricOf(t, httpRequestsTotal.WithLabelValues("GET", "/api/v1/cluster/:name", "204")).GetCounter().GetValue(); value != 2 {
+		t.Errorf("requests_total{route=/api/v1/cluster/:name} == %v, expected 2", value)
+	}
+	if value := metricOf(t, httpRequestsTotal.WithLabelValues("GET", unmatchedRoute, "404")).GetCounter().GetValue(); value != 1 {
+		t.Errorf("requests_total{route=unmatched} == %v, expected 1", value)
+	}
+}
+
+func TestObserveInternalCall(t *testing.T) {
+	ObserveInternalCall(TargetVault, 0.5, nil)
+	ObserveInternalCall(TargetVault, 1, errors.New("unavailable"))
+	ObserveInternalCall(TargetVault, 1, errors.New("unavailable"))
+
+	if value := metricOf(t, internalCallsTotal.WithLabelValues(TargetVault, ResultSuccess)).GetCounter().GetValue(); value != 1 {
+		t.Errorf("calls_total{result=success} == %v, expected 1", value)
+	}
+	if count := metricOf(t, internalCallDuration.WithLabelValues(TargetVault, ResultFailure)).GetHistogram().GetSampleCount(); count != 2 {
+		t.Errorf("call_duration_seconds{result=failure} count == %d, expected 2", count)
+	}
+}
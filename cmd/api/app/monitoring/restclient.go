@@ -0,0 +1,119 @@
+package monitoring
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"k8s.io/client-go/rest"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+)
+
+const (
+	targetKarmada = "karmada"
+	targetHost    = "host"
+	targetMember  = "member"
+)
+
+// clusterProxyPrefix is the path prefix of the requests to member clusters through the Karmada aggregated proxy,
+// followed by the cluster name and /proxy/.
+const clusterProxyPrefix = "/apis/cluster.karmada.io/v1alpha1/clusters/"
+
+// The latency hook of client-go has the URL of a request, so a request to a member cluster through the Karmada
+// proxy is classified as member by its path. The result hook only has the host, where these requests are karmada.
+
+type requestLatency struct{}
+
+func (requestLatency) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
+	target := apiServers.targetOf(u.Host)
+	if isClusterProxyPath(u.Path) {
+		target = targetMember
+	}
+	kubeClientRequestDuration.WithLabelValues(target, verb).Observe(latency.Seconds())
+}
+
+type requestResult struct{}
+
+func (requestResult) Increment(_ context.Context, code string, method string, host string) {
+	kubeClientRequestsTotal.WithLabelValues(apiServers.targetOf(host), method, code).Inc()
+}
+
+// isClusterProxyPath returns whether path is a request to a member cluster through the Karmada proxy.
+func isClusterProxyPath(path string) bool {
+	rest, found := strings.CutPrefix(path, clusterProxyPrefix)
+	if !found {
+		return false
+	}
+	name, rest, found := strings.Cut(rest, "/")
+	return found && name != "" && (rest == "proxy" || strings.HasPrefix(rest, "proxy/"))
+}
+
+// registerKubeClientMetrics hooks the client-go request metrics, which cover every
+// rest client created by the process.
+func registerKubeClientMetrics() {
+	clientmetrics.Register(clientmetrics.RegisterOpts{
+		RequestLatency: requestLatency{},
+		RequestResult:  requestResult{},
+	})
+}
+
+var apiServers = &apiServerHosts{resolve: resolveAPIServerHosts}
+
+// apiServerHosts are the hosts of the karmada and host apiservers, resolved once the clients are initialized.
+type apiServerHosts struct {
+	mu       sync.RWMutex
+	resolved bool
+	karmada  string
+	host     string
+	resolve  func() (karmada string, host string, ok bool)
+}
+
+// targetOf classifies the apiserver host of a request as karmada, host or member.
+func (h *apiServerHosts) targetOf(host string) string {
+	h.mu.RLock()
+	resolved, karmada, kube := h.resolved, h.karmada, h.host
+	h.mu.RUnlock()
+	if !resolved {
+		resolvedKarmada, resolvedKube, ok := h.resolve()
+		if !ok {
+			return targetMember
+		}
+		h.mu.Lock()
+		h.resolved, h.karmada, h.host = true, resolvedKarmada, resolvedKube
+		h.mu.Unlock()
+		karmada, kube = resolvedKarmada, resolvedKube
+	}
+
+	switch host {
+	case karmada:
+		return targetKarmada
+	case kube:
+		return targetHost
+	}
+	return targetMember
+}
+
+// resolveAPIServerHosts returns the hosts of the karmada and host apiservers, ok is false until the clients of
+// both are initialized.
+func resolveAPIServerHosts() (string, string, bool) {
+	karmadaConfig, _, err := client.GetKarmadaConfig()
+	if err != nil {
+		return "", "", false
+	}
+	kubeConfig, _, err := client.GetKubeConfig()
+	if err != nil {
+		return "", "", false
+	}
+	return hostOf(karmadaConfig), hostOf(kubeConfig), true
+}
+
+func hostOf(restConfig *rest.Config) string {
+	u, err := url.Parse(restConfig.Host)
+	if err != nil || u.Host == "" {
+		return restConfig.Host
+	}
+	return u.Host
+}
@@ -42,4 +42,26 @@ const (
 	ClusterMappingSaveFailed                   = "CLUSTER_MAPPING_SAVE_FAILED"
 	ClusterLoadConfigFailed                    = "CLUSTER_LOAD_CONFIG_FAILED"
 	NotAllowedNamespace                        = "NOT_ALLOWED_NAMESPACE"
+	SyncSourceNotFound                         = "SYNC_SOURCE_NOT_FOUND"
+	SyncPermissionDenied                       = "SYNC_PERMISSION_DENIED"
+	SyncReportNotFound                         = "SYNC_REPORT_NOT_FOUND"
+	SyncExcludedByLabelSelector                = "SYNC_EXCLUDED_BY_LABEL_SELECTOR"
+	SyncExcludedOwnedResource                  = "SYNC_EXCLUDED_OWNED_RESOURCE"
+	SyncExcludedKarmadaManaged                 = "SYNC_EXCLUDED_KARMADA_MANAGED"
+	SyncJobNotFound                            = "SYNC_JOB_NOT_FOUND"
+	ManifestDocumentSkipped                    = "MANIFEST_DOCUMENT_SKIPPED"
+	ResourceApplyConflict                      = "RESOURCE_APPLY_CONFLICT"
+	ResourceApplySuccess                       = "RESOURCE_APPLY_SUCCESS"
+	ResourceDryRunSuccess                      = "RESOURCE_DRY_RUN_SUCCESS"
+	ResourceRevisionNotFound                   = "RESOURCE_REVISION_NOT_FOUND"
+	ResourceRollbackSuccess                    = "RESOURCE_ROLLBACK_SUCCESS"
+	ResourceScaleSuccess                       = "RESOURCE_SCALE_SUCCESS"
+	ResourceRestartSuccess                     = "RESOURCE_RESTART_SUCCESS"
+	ResourceNotPropagated                      = "RESOURCE_NOT_PROPAGATED"
+	CronJobTriggerSuccess                      = "CRONJOB_TRIGGER_SUCCESS"
+	CronJobSuspendSuccess                      = "CRONJOB_SUSPEND_SUCCESS"
+	CronJobResumeSuccess                       = "CRONJOB_RESUME_SUCCESS"
+	BulkOperationCompleted                     = "BULK_OPERATION_COMPLETED"
+	SyncSkippedExisting                        = "SYNC_SKIPPED_EXISTING"
+	SyncRenameExhausted                        = "SYNC_RENAME_EXHAUSTED"
 )
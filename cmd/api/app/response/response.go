@@ -23,6 +23,11 @@ func Success(c *gin.Context, obj interface{}) {
 	c.AbortWithStatusJSON(http.StatusOK, obj)
 }
 
+// Accepted generate response for a request that is processed in the background
+func Accepted(c *gin.Context, obj interface{}) {
+	c.AbortWithStatusJSON(http.StatusAccepted, obj)
+}
+
 func Created(c *gin.Context) {
 	code := http.StatusCreated
 	c.AbortWithStatusJSON(code, BaseResponse{
@@ -39,6 +44,16 @@ func SuccessWithMessage(c *gin.Context, msg string) {
 	})
 }
 
+// SuccessWithData generate success response with a message and the data it refers to
+func SuccessWithData(c *gin.Context, msg string, data interface{}) {
+	code := http.StatusOK
+	c.AbortWithStatusJSON(code, BaseResponse{
+		Code: code,
+		Msg:  localize.GetLocalizeMessage(c, msg),
+		Data: data,
+	})
+}
+
 func Unauthorized(c *gin.Context, msg string) {
 	code := http.StatusUnauthorized
 	c.AbortWithStatusJSON(code, BaseResponse{
@@ -64,6 +79,16 @@ func FailedWithError(c *gin.Context, err error) {
 	Failed(c, errmsg.RequestFailed)
 }
 
+// FailedWithData generate error response of err with the details of the error
+func FailedWithData(c *gin.Context, err *apperrors.HttpError, data interface{}) {
+	klog.ErrorS(err, "handling error")
+	c.AbortWithStatusJSON(err.Code, BaseResponse{
+		Code: err.Code,
+		Msg:  localize.GetLocalizeMessage(c, err.Msg),
+		Data: data,
+	})
+}
+
 func Failed(c *gin.Context, msg string, statusCode ...int) {
 	code := http.StatusInternalServerError
 	if len(statusCode) > 0 {
@@ -20,6 +20,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/dashboard/cmd/api/app/adapter/auth"
 	"github.com/karmada-io/dashboard/cmd/api/app/adapter/federation"
+	"github.com/karmada-io/dashboard/cmd/api/app/metrics"
+	"github.com/karmada-io/dashboard/cmd/api/app/monitoring"
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/clusterpropagationpolicy"
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/namespace"
@@ -29,19 +31,26 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/routes/sync"
 	"github.com/karmada-io/dashboard/pkg/environment"
 	"net/http"
+	"time"
 )
 
 var (
-	router   *gin.Engine
-	v1       *gin.RouterGroup
-	member   *gin.RouterGroup
-	StatusUp = "UP"
+	router     *gin.Engine
+	v1         *gin.RouterGroup
+	member     *gin.RouterGroup
+	StatusUp   = "UP"
+	StatusDown = "DOWN"
 )
 
 type HealthStatus struct {
 	Status string `json:"status"`
 }
 
+type MetricsHealthStatus struct {
+	Status         string     `json:"status"`
+	LastUpdateTime *time.Time `json:"lastUpdateTime"`
+}
+
 func init() {
 	authAdapter := auth.NewInternalAuthAdapter()
 	fedAdapter := federation.NewInternalAdapter(authAdapter)
@@ -51,6 +60,7 @@ func init() {
 	}
 
 	router = gin.Default()
+	router.Use(monitoring.HTTPMetricsMiddleware())
 	router.Use(CORSMiddleware())
 
 	router.GET("/livez", func(c *gin.Context) {
@@ -60,12 +70,22 @@ func init() {
 		c.String(200, "readyz")
 	})
 
+	router.GET("/metrics", gin.WrapH(monitoring.Handler()))
+
 	router.GET("/actuator/health/liveness", func(c *gin.Context) {
 		c.JSON(http.StatusOK, HealthStatus{Status: StatusUp})
 	})
 	router.GET("/actuator/health/readiness", func(c *gin.Context) {
 		c.JSON(http.StatusOK, HealthStatus{Status: StatusUp})
 	})
+	router.GET("/actuator/health/metrics", func(c *gin.Context) {
+		lastUpdateTime := metrics.LastUpdateTime()
+		if lastUpdateTime.IsZero() {
+			c.JSON(http.StatusServiceUnavailable, MetricsHealthStatus{Status: StatusDown})
+			return
+		}
+		c.JSON(http.StatusOK, MetricsHealthStatus{Status: StatusUp, LastUpdateTime: &lastUpdateTime})
+	})
 
 	router.Use(LanguageMiddleware())
 	router.Use(authAdapter.AuthMiddleware())
@@ -79,6 +99,7 @@ func init() {
 	clusterV1.GET("/registrable-clusters", clusterHandler.HandleListRegisterableClusters)
 	clusterV1.GET("/cluster", clusterHandler.HandleListClusters)
 	clusterV1.GET("/cluster/:clusterId", clusterHandler.HandleGetClusterYaml)
+	clusterV1.GET("/cluster/:clusterId/usage", clusterHandler.HandleGetClusterUsage)
 	clusterV1.POST("/cluster", clusterHandler.HandlePostCluster)
 	clusterV1.DELETE("/cluster/:clusterId", clusterHandler.HandleDeleteCluster)
 
@@ -117,15 +138,30 @@ func init() {
 	resourceV1.GET("/labels", resources.HandleGetResourceLabels)
 	resourceV1.GET("/:kind", resources.HandleListResource)
 	resourceV1.GET("/:kind/namespace/:namespace/name/:name", resources.HandleGetResourceYaml)
+	resourceV1.GET("/:kind/namespace/:namespace/name/:name/revisions", resources.HandleGetResourceRevisions)
+	resourceV1.POST("/:kind/namespace/:namespace/name/:name/rollback", resources.HandleRollbackResource)
+	resourceV1.PUT("/:kind/namespace/:namespace/name/:name/scale", resources.HandleScaleResource)
+	resourceV1.POST("/:kind/namespace/:namespace/name/:name/restart", resources.HandleRestartResource)
+	resourceV1.POST("/:kind/namespace/:namespace/name/:name/trigger", resources.HandleTriggerCronJob)
+	resourceV1.POST("/:kind/namespace/:namespace/name/:name/suspend", resources.HandleSuspendCronJob)
+	resourceV1.POST("/:kind/namespace/:namespace/name/:name/resume", resources.HandleResumeCronJob)
+	resourceV1.GET("/:kind/namespace/:namespace/name/:name/jobs", resources.HandleGetCronJobJobs)
 	resourceV1.POST("", resources.HandleCreateResource)
 	resourceV1.PUT("", resources.HandleUpdateResource)
 	resourceV1.DELETE("/:kind/namespace/:namespace/name/:name", resources.HandleDeleteResource)
+	resourceV1.POST("/bulk", resources.HandleBulkResources)
 
 	// sync
 	syncV1 := v1.Group("/sync")
 	syncHandler := sync.NewHandler(fedAdapter)
+	syncV1.GET("/kind/:clusterId", syncHandler.HandleGetSyncResourceKinds)
 	syncV1.GET("/resource/:clusterId", syncHandler.HandleGetSyncResources)
 	syncV1.POST("/:clusterId", syncHandler.HandlePostSync)
+	syncV1.POST("/merge", syncHandler.HandlePostMergeSync)
+	syncV1.GET("/report/:clusterId", syncHandler.HandleGetSyncReports)
+	syncV1.GET("/report/:clusterId/:reportId", syncHandler.HandleGetSyncReport)
+	syncV1.GET("/jobs/:jobId", syncHandler.HandleGetSyncJob)
+	syncV1.DELETE("/jobs/:jobId", syncHandler.HandleDeleteSyncJob)
 
 	member = v1.Group("/member/:clustername")
 	member.Use(EnsureMemberClusterMiddleware())
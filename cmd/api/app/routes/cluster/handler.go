@@ -22,11 +22,14 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/adapter/federation"
 	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
 	"github.com/karmada-io/dashboard/cmd/api/app/localize"
+	"github.com/karmada-io/dashboard/cmd/api/app/metrics"
+	"github.com/karmada-io/dashboard/cmd/api/app/monitoring"
 	errmsg "github.com/karmada-io/dashboard/cmd/api/app/msgkey"
 	"github.com/karmada-io/dashboard/cmd/api/app/response"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/cluster"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"net/http"
 	"sync"
@@ -83,6 +86,33 @@ func (h *Handler) HandleGetClusterYaml(c *gin.Context) {
 	response.Success(c, result)
 }
 
+func (h *Handler) HandleGetClusterUsage(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+	from, to, step, err := parseUsageRange(c)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+
+	known, err := h.isUsageTarget(clusterId)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	if !known {
+		response.FailedWithError(c, apperrors.ClusterNotFound)
+		return
+	}
+
+	response.Success(c, v1.ClusterUsageHistory{
+		ClusterId: clusterId,
+		From:      metav1.NewTime(from),
+		To:        metav1.NewTime(to),
+		Step:      step.String(),
+		Samples:   metrics.GetClusterUsageHistory(clusterId, from, to, step),
+	})
+}
+
 func (h *Handler) HandleListRegisterableClusters(c *gin.Context) {
 	// Fetch clusters managed by the service
 	managedClusters, err := h.Adapter.GetManagedClusters()
@@ -117,6 +147,7 @@ func (h *Handler) HandlePostCluster(c *gin.Context) {
 			defer wg.Done()
 			result := RegisterResult{ClusterId: targetClusterId}
 			register, err := h.RegisterMemberCluster(c, targetClusterId, managedClusters.Items)
+			monitoring.ObserveClusterRegistration(err)
 			if err != nil {
 				result.Code = http.StatusInternalServerError
 				result.Message = localize.GetLocalizeMessage(c, errmsg.RequestFailed)
@@ -20,6 +20,12 @@ import (
 	"time"
 )
 
+const (
+	defaultUsageRange = time.Hour
+	defaultUsageStep  = time.Minute
+	maxUsageSamples   = 1440
+)
+
 type RegisterResult struct {
 	ClusterId string `json:"clusterId"`
 	Name      string `json:"name"`
@@ -156,6 +162,56 @@ func DeleteCluster(c *gin.Context, karmadaClient karmadaclientset.Interface, clu
 	return nil
 }
 
+// parseUsageRange parses the from/to (RFC3339) and step (duration) query parameters of the usage API.
+// It defaults to the last hour with a step of one minute.
+func parseUsageRange(c *gin.Context) (time.Time, time.Time, time.Duration, error) {
+	to := time.Now()
+	if v := c.Query("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, apperrors.RequestValueInvalid
+		}
+		to = t
+	}
+
+	from := to.Add(-defaultUsageRange)
+	if v := c.Query("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, apperrors.RequestValueInvalid
+		}
+		from = t
+	}
+
+	step := defaultUsageStep
+	if v := c.Query("step"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, apperrors.RequestValueInvalid
+		}
+		step = d
+	}
+
+	if !from.Before(to) || step <= 0 || to.Sub(from)/step > maxUsageSamples {
+		return time.Time{}, time.Time{}, 0, apperrors.RequestValueInvalid
+	}
+	return from, to, step, nil
+}
+
+// isUsageTarget reports whether usage can be queried for the cluster,
+// i.e. it is the host cluster or a federated member cluster.
+func (h *Handler) isUsageTarget(clusterID string) (bool, error) {
+	if usage, err := metrics.GetClustersRealTimeUsage(); err == nil && usage.HostClusterStatus.ClusterId == clusterID {
+		return true, nil
+	}
+
+	managedClusters, err := h.Adapter.GetManagedClusters()
+	if err != nil {
+		return false, err
+	}
+	return FindFederatedClusterByID(managedClusters.Items, clusterID) != nil, nil
+}
+
 func FindFederatedClusterByID(clusters []domain.Cluster, clusterID string) *domain.Cluster {
 	for _, c := range clusters {
 		if c.ClusterID == clusterID && c.IsFederated {
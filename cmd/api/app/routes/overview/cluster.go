@@ -16,8 +16,11 @@ type CustomOverviewResponse struct {
 
 func setHostClusterStatus(metricsOpt *metrics.ClusterUsage) metrics.Status {
 	if metricsOpt != nil {
-		metricsOpt.HostClusterStatus.Status = cluster.GetConditionStatus(metricsOpt.HostClusterStatus.Status)
-		return metricsOpt.HostClusterStatus
+		hostStatus := metricsOpt.HostClusterStatus
+		hostStatus.Status = cluster.GetConditionStatus(hostStatus.Status)
+		hostStatus.RealTimeUsage = metrics.StampUsage(hostStatus.RealTimeUsage, metricsOpt.Time.Time)
+		hostStatus.RequestUsage = metrics.StampUsage(hostStatus.RequestUsage, metricsOpt.Time.Time)
+		return hostStatus
 	}
 	return metrics.Status{
 		NodeSummary:   &v1.NodeSummary{},
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+)
+
+// Actions of a bulk request.
+const (
+	BulkActionDelete   = "delete"
+	BulkActionLabel    = "label"
+	BulkActionAnnotate = "annotate"
+	BulkActionScale    = "scale"
+	BulkActionRestart  = "restart"
+)
+
+// maxBulkResources is the number of resources a bulk request acts on at most.
+const maxBulkResources = 500
+
+// bulkConcurrency is the number of resources a bulk request acts on at once.
+const bulkConcurrency = 10
+
+// validateBulkRequest checks the parameters of the action of the request, and that it has either resources or a
+// selector.
+func validateBulkRequest(request *v1.BulkRequest) error {
+	if (len(request.Resources) == 0) == (request.Selector == nil) || len(request.Resources) > maxBulkResources {
+		return apperrors.RequestValueInvalid
+	}
+	switch request.Action {
+	case BulkActionDelete:
+		if request.Policies == "" {
+			request.Policies = PolicyDeletionOrphan
+		}
+		if request.Policies != PolicyDeletionOrphan && request.Policies != PolicyDeletionCascade {
+			return apperrors.RequestValueInvalid
+		}
+	case BulkActionLabel:
+		if len(request.Labels) == 0 {
+			return apperrors.RequestValueInvalid
+		}
+		for key, value := range request.Labels {
+			if len(validation.IsQualifiedName(key)) > 0 || (value != nil && len(validation.IsValidLabelValue(*value)) > 0) {
+				return apperrors.InvalidKeyValueFormat
+			}
+		}
+	case BulkActionAnnotate:
+		if len(request.Annotations) == 0 {
+			return apperrors.RequestValueInvalid
+		}
+		for key := range request.Annotations {
+			if len(validation.IsQualifiedName(key)) > 0 {
+				return apperrors.InvalidKeyValueFormat
+			}
+		}
+	case BulkActionScale:
+		if request.Replicas == nil {
+			return apperrors.RequestValueInvalid
+		}
+	}
+	return nil
+}
+
+type bulkOperation struct {
+	verber      client.ResourceVerber
+	request     *v1.BulkRequest
+	dryRun      bool
+	triggeredBy string
+	now         time.Time
+}
+
+// bulkTarget is a resource of a bulk request, the object is read by the action if the resource is referenced.
+type bulkTarget struct {
+	ref v1.ResourceReference
+	obj *unstructured.Unstructured
+}
+
+// run acts on the resources of the request, bulkConcurrency at a time. The errors are reported by resource, so
+// that a resource that fails does not stop the action on the other resources.
+func (o *bulkOperation) run() (*v1.BulkResult, error) {
+	targets, err := o.targets()
+	if err != nil {
+		return nil, err
+	}
+	kindErrors := make(map[string]error)
+	for _, target := range targets {
+		if _, ok := kindErrors[target.ref.Kind]; !ok {
+			kindErrors[target.ref.Kind] = o.validateKind(target.ref.Kind)
+		}
+	}
+
+	result := &v1.BulkResult{Action: o.request.Action, DryRun: o.dryRun, Items: make([]v1.BulkItemResult, len(targets))}
+	semaphore := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			item := v1.BulkItemResult{ResourceReference: target.ref}
+			err := kindErrors[target.ref.Kind]
+			if err == nil {
+				err = o.act(target)
+			}
+			if err != nil {
+				klog.ErrorS(err, "Bulk action failed", "action", o.request.Action, "kind", target.ref.Kind, "namespace", target.ref.Namespace, "name", target.ref.Name)
+				item.Error = err.Error()
+			}
+			result.Items[i] = item
+		}()
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		if item.Error == "" {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// targets returns the referenced resources, or the resources of the selector.
+func (o *bulkOperation) targets() ([]bulkTarget, error) {
+	if o.request.Selector == nil {
+		targets := make([]bulkTarget, 0, len(o.request.Resources))
+		for _, ref := range o.request.Resources {
+			if ref.Kind == "" || ref.Namespace == "" || ref.Name == "" {
+				return nil, apperrors.RequestValueInvalid
+			}
+			ref.Kind = strings.ToLower(ref.Kind)
+			targets = append(targets, bulkTarget{ref: ref})
+		}
+		return targets, nil
+	}
+
+	selector, err := labels.Parse(o.request.Selector.LabelSelector)
+	if err != nil || selector.Empty() {
+		// an empty selector would select every resource of the kind
+		return nil, apperrors.RequestValueInvalid
+	}
+	kind := strings.ToLower(o.request.Selector.Kind)
+	if err := validatePathKind(o.verber, kind); err != nil {
+		return nil, err
+	}
+	list, err := o.verber.List(kind, o.request.Selector.Namespace)
+	if err != nil {
+		return nil, apperrors.ResourceError(err)
+	}
+	targets := make([]bulkTarget, 0)
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		ref := v1.ResourceReference{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		targets = append(targets, bulkTarget{ref: ref, obj: obj})
+	}
+	if len(targets) > maxBulkResources {
+		return nil, apperrors.RequestValueInvalid
+	}
+	return targets, nil
+}
+
+// validateKind checks that the action applies to the kind.
+func (o *bulkOperation) validateKind(kind string) error {
+	switch o.request.Action {
+	case BulkActionScale:
+		if !types.ResourceKind(kind).Scalable() {
+			return apperrors.UnsupportedResourceKind
+		}
+	case BulkActionRestart:
+		if !types.ResourceKind(kind).Restartable() {
+			return apperrors.UnsupportedResourceKind
+		}
+	}
+	return validatePathKind(o.verber, kind)
+}
+
+func (o *bulkOperation) act(target bulkTarget) error {
+	obj := target.obj
+	if obj == nil {
+		got, err := o.verber.Get(target.ref.Kind, target.ref.Namespace, target.ref.Name)
+		if err != nil {
+			return err
+		}
+		obj = got.(*unstructured.Unstructured)
+	}
+
+	switch o.request.Action {
+	case BulkActionDelete:
+		_, err := deleteResource(o.verber, target.ref.Kind, obj, o.request.Policies)
+		return err
+	case BulkActionLabel:
+		updated := obj.DeepCopy()
+		updated.SetLabels(mergeStringMap(updated.GetLabels(), o.request.Labels))
+		return o.verber.Update(updated)
+	case BulkActionAnnotate:
+		updated := obj.DeepCopy()
+		updated.SetAnnotations(mergeStringMap(updated.GetAnnotations(), o.request.Annotations))
+		return o.verber.Update(updated)
+	case BulkActionScale:
+		return setReplicas(o.verber, obj, *o.request.Replicas)
+	case BulkActionRestart:
+		if _, err := restartTemplate(o.verber, obj, o.now); err != nil {
+			return err
+		}
+		if !o.dryRun && revisionKinds[target.ref.Kind] {
+			recordRevision(o.verber, target.ref.Kind, obj, RevisionCauseRestart, o.triggeredBy)
+		}
+		return nil
+	}
+	return apperrors.RequestValueInvalid
+}
+
+// mergeStringMap returns m with the values of changes, a nil value removes the key.
+func mergeStringMap(m map[string]string, changes map[string]*string) map[string]string {
+	merged := make(map[string]string, len(m)+len(changes))
+	for k, v := range m {
+		merged[k] = v
+	}
+	for k, v := range changes {
+		if v == nil {
+			delete(merged, k)
+		} else {
+			merged[k] = *v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestValidateBulkRequest(t *testing.T) {
+	web := []v1.ResourceReference{{Kind: "deployment", Namespace: "default", Name: "web"}}
+	selector := &v1.BulkSelector{Kind: "deployment", LabelSelector: "app=web"}
+	invalid := "not a value!"
+	replicas := int32(2)
+	cases := []struct {
+		request  v1.BulkRequest
+		expected error
+	}{
+		{v1.BulkRequest{Action: BulkActionDelete, Resources: web}, nil},
+		{v1.BulkRequest{Action: BulkActionDelete, Selector: selector, Policies: "foreground"}, apperrors.RequestValueInvalid},
+		// either resources or a selector
+		{v1.BulkRequest{Action: BulkActionDelete}, apperrors.RequestValueInvalid},
+		{v1.BulkRequest{Action: BulkActionDelete, Resources: web, Selector: selector}, apperrors.RequestValueInvalid},
+		{v1.BulkRequest{Action: BulkActionLabel, Resources: web, Labels: map[string]*string{"team": nil}}, nil},
+		{v1.BulkRequest{Action: BulkActionLabel, Resources: web, Labels: map[string]*string{"team": &invalid}}, apperrors.InvalidKeyValueFormat},
+		{v1.BulkRequest{Action: BulkActionAnnotate, Resources: web}, apperrors.RequestValueInvalid},
+		{v1.BulkRequest{Action: BulkActionScale, Resources: web}, apperrors.RequestValueInvalid},
+		{v1.BulkRequest{Action: BulkActionScale, Resources: web, Replicas: &replicas}, nil},
+	}
+	for _, c := range cases {
+		if actual := validateBulkRequest(&c.request); actual != c.expected {
+			t.Errorf("validateBulkRequest(%#v) == %v, expected %v", c.request, actual, c.expected)
+		}
+	}
+}
+
+func TestBulkOperation(t *testing.T) {
+	deployment := func(name string, labels map[string]string) *unstructured.Unstructured {
+		template := deploymentTemplate("nginx:1.25", 2)
+		template.SetName(name)
+		template.SetLabels(labels)
+		return template
+	}
+	verber := &fakeVerber{objects: []*unstructured.Unstructured{
+		deployment("web", map[string]string{"app": "web", "team": "a"}),
+		deployment("web-canary", map[string]string{"app": "web"}),
+		deployment("api", map[string]string{"app": "api"}),
+	}}
+	team := "b"
+	operation := &bulkOperation{
+		verber: verber,
+		request: &v1.BulkRequest{
+			Action:   BulkActionLabel,
+			Selector: &v1.BulkSelector{Kind: "Deployment", Namespace: "default", LabelSelector: "app=web"},
+			Labels:   map[string]*string{"team": &team, "app": nil},
+		},
+		now: time.Now(),
+	}
+	actual, err := operation.run()
+	if err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if actual.Succeeded != 2 || actual.Failed != 0 || len(verber.updated) != 2 {
+		t.Fatalf("run() == %#v, expected 2 deployments labelled", actual)
+	}
+	for _, updated := range verber.updated {
+		if expected := map[string]string{"team": "b"}; !reflect.DeepEqual(updated.GetLabels(), expected) {
+			t.Errorf("run() labelled %s with %#v, expected %#v", updated.GetName(), updated.GetLabels(), expected)
+		}
+	}
+
+	// a missing resource fails alone
+	operation.request = &v1.BulkRequest{Action: BulkActionDelete, Policies: PolicyDeletionOrphan, Resources: []v1.ResourceReference{
+		{Kind: "Deployment", Namespace: "default", Name: "api"},
+		{Kind: "deployment", Namespace: "default", Name: "missing"},
+		{Kind: "configmap", Namespace: "default", Name: "settings"},
+	}}
+	actual, err = operation.run()
+	if err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if actual.Succeeded != 1 || actual.Failed != 2 || actual.Items[0].Error != "" || actual.Items[1].Error == "" {
+		t.Errorf("run() == %#v, expected api deleted and missing failed", actual)
+	}
+	sort.Strings(verber.deleted)
+	if !reflect.DeepEqual(verber.deleted, []string{"deployment/default/api"}) {
+		t.Errorf("run() deleted %#v, expected the api deployment", verber.deleted)
+	}
+
+	// scale applies to scalable kinds only
+	replicas := int32(0)
+	operation.request = &v1.BulkRequest{Action: BulkActionScale, Replicas: &replicas, Resources: []v1.ResourceReference{
+		{Kind: "configmap", Namespace: "default", Name: "settings"},
+	}}
+	actual, err = operation.run()
+	if err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if actual.Failed != 1 || actual.Items[0].Error != apperrors.UnsupportedResourceKind.Error() {
+		t.Errorf("run() == %#v, expected an unsupported kind", actual)
+	}
+}
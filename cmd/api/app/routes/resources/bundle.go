@@ -0,0 +1,311 @@
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/cmd/api/app/localize"
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"github.com/karmada-io/dashboard/cmd/api/app/response"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// Outcomes of a document of a multi-document manifest.
+const (
+	DocumentCreated = "created"
+	DocumentApplied = "applied"
+	DocumentFailed  = "failed"
+	DocumentSkipped = "skipped"
+)
+
+// bundleOnlyKinds are the kinds a multi-document manifest can create in addition to the supported kinds and
+// custom resources, so that a bundle sets up the namespaces, definitions and permissions of its workloads.
+var bundleOnlyKinds = map[schema.GroupKind]bool{
+	{Kind: "Namespace"}: true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:         true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:  true,
+}
+
+// manifestKindOrder is the order in which the kinds of a multi-document manifest are applied,
+// so that namespaces, definitions and configuration exist before the workloads using them.
+// Other kinds, such as custom resources, are applied last, in the order of the manifest.
+var manifestKindOrder = []string{
+	"Namespace",
+	"CustomResourceDefinition",
+	// policies and RBAC
+	"NetworkPolicy",
+	"ServiceAccount",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	// configuration and storage
+	"Secret",
+	"ConfigMap",
+	"PersistentVolumeClaim",
+	// workloads
+	"Service",
+	"DaemonSet",
+	"Deployment",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"HorizontalPodAutoscaler",
+	"Ingress",
+}
+
+func kindRank(kind string) int {
+	for i, k := range manifestKindOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(manifestKindOrder)
+}
+
+// splitManifest parses the YAML documents or JSON objects of a manifest, with the items of List objects expanded.
+// It returns whether the manifest is a bundle, i.e. has several documents or a List.
+func splitManifest(data string) ([]*unstructured.Unstructured, bool, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(data)))
+	var objects []*unstructured.Unstructured
+	isList := false
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, apperrors.InvalidYamlFormat
+		}
+		jsonBytes, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, false, apperrors.InvalidYamlFormat
+		}
+		// empty documents, e.g. only comments or a trailing separator
+		if trimmed := bytes.TrimSpace(jsonBytes); len(trimmed) == 0 || string(trimmed) == "null" {
+			continue
+		}
+
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(jsonBytes); err != nil {
+			return nil, false, apperrors.InvalidYamlFormat
+		}
+		if !obj.IsList() {
+			objects = append(objects, &obj)
+			continue
+		}
+		isList = true
+		list, err := obj.ToList()
+		if err != nil {
+			return nil, false, apperrors.InvalidYamlFormat
+		}
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	}
+	if len(objects) == 0 {
+		return nil, false, apperrors.InvalidYamlFormat
+	}
+	return objects, isList || len(objects) > 1, nil
+}
+
+// handleManifestBundle creates the objects of a multi-document manifest and returns a result per object.
+// On a dry run every object is validated against the current state, so objects in a namespace created
+// by the same manifest fail with NamespaceNotFound.
+func handleManifestBundle(c *gin.Context, objects []*unstructured.Unstructured, stopOnError bool, options mutationOptions) {
+	verber, err := client.VerberClientWithOptions(c.Request, client.VerberOptions{DryRun: options.dryRun})
+	if err != nil {
+		klog.ErrorS(err, "Failed to init verber client")
+		response.FailedWithError(c, err)
+		return
+	}
+	applier := &manifestApplier{
+		options:          options,
+		ctx:              c.Request.Context(),
+		dynamicClient:    client.InClusterDynamicClientForKarmadaAPIServer(),
+		mapper:           restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.InClusterClientForKarmadaAPIServer().Discovery())),
+		verber:           verber,
+		revisionRecorder: recordRevision,
+		triggeredBy:      userID(c),
+		localize: func(key string) string {
+			return localize.GetLocalizeMessage(c, key)
+		},
+	}
+	response.Success(c, applier.apply(objects, stopOnError))
+}
+
+type manifestApplier struct {
+	options       mutationOptions
+	ctx           context.Context
+	dynamicClient dynamic.Interface
+	mapper        *restmapper.DeferredDiscoveryRESTMapper
+	// verber checks the kinds of custom resources and reads the created templates for their revisions
+	verber client.ResourceVerber
+	// revisionRecorder records the revision of a created workload, like the single-document create does
+	revisionRecorder func(verber client.ResourceVerber, kind string, obj *unstructured.Unstructured, cause, triggeredBy string)
+	triggeredBy      string
+	localize         func(key string) string
+}
+
+// apply creates the objects in dependency order. The results keep the order of the manifest.
+func (a *manifestApplier) apply(objects []*unstructured.Unstructured, stopOnError bool) v1.ManifestApplyResult {
+	order := make([]int, len(objects))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return kindRank(objects[order[i]].GetKind()) < kindRank(objects[order[j]].GetKind())
+	})
+
+	result := v1.ManifestApplyResult{
+		DryRun:  a.options.dryRun,
+		Total:   len(objects),
+		Results: make([]v1.ManifestDocumentResult, len(objects)),
+	}
+	stopped := false
+	for _, i := range order {
+		obj := objects[i]
+		document := v1.ManifestDocumentResult{
+			Index:      i,
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+		}
+		switch {
+		case stopped:
+			document.Outcome = DocumentSkipped
+			document.Code = http.StatusFailedDependency
+			document.Message = a.localize(msgkey.ManifestDocumentSkipped)
+			result.Skipped++
+		default:
+			kind, err := a.validateKind(obj)
+			if err == nil {
+				err = a.create(obj)
+			}
+			document.Namespace = obj.GetNamespace()
+			if err != nil {
+				klog.ErrorS(err, "Failed to create manifest document", "index", i, "kind", obj.GetKind(), "name", obj.GetName())
+				document.Outcome = DocumentFailed
+				document.Code, document.Message = a.failure(err)
+				document.Detail = err.Error()
+				document.Conflicts = applyConflicts(err)
+				result.Failed++
+				stopped = stopOnError
+				break
+			}
+			document.Outcome, document.Code, document.Message = a.success()
+			result.Succeeded++
+			if !a.options.dryRun && revisionKinds[kind] {
+				cause := RevisionCauseCreate
+				if a.options.serverSideApply {
+					cause = RevisionCauseApply
+				}
+				a.revisionRecorder(a.verber, kind, obj, cause, a.triggeredBy)
+			}
+		}
+		result.Results[i] = document
+	}
+	return result
+}
+
+// success returns the outcome, the status code and the localized message of a document applied without error.
+func (a *manifestApplier) success() (string, int, string) {
+	outcome, code, msg := DocumentCreated, http.StatusCreated, msgkey.ResourceCreateSuccess
+	if a.options.serverSideApply {
+		outcome, code, msg = DocumentApplied, http.StatusOK, msgkey.ResourceApplySuccess
+	}
+	if a.options.dryRun {
+		msg = msgkey.ResourceDryRunSuccess
+	}
+	return outcome, code, a.localize(msg)
+}
+
+// validateKind checks that the kind of obj can be created by a bundle, and returns the kind used to get the
+// resource with the verber.
+func (a *manifestApplier) validateKind(obj *unstructured.Unstructured) (string, error) {
+	gvk := obj.GroupVersionKind()
+	if bundleOnlyKinds[gvk.GroupKind()] {
+		return strings.ToLower(gvk.Kind), nil
+	}
+	return validateManifestKind(a.verber, gvk)
+}
+
+// create creates or applies obj, in the default namespace if it is namespaced and has no namespace.
+func (a *manifestApplier) create(obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// the kind may be defined by a CustomResourceDefinition created earlier in the manifest
+		a.mapper.Reset()
+		mapping, err = a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return err
+	}
+
+	var resource dynamic.ResourceInterface = a.dynamicClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		obj.SetNamespace("")
+	} else {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(defaultNamespace)
+		}
+		resource = a.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+	}
+
+	var dryRun []string
+	if a.options.dryRun {
+		dryRun = []string{metav1.DryRunAll}
+	}
+	if a.options.serverSideApply {
+		obj.SetManagedFields(nil)
+		_, err = resource.Apply(a.ctx, obj.GetName(), obj, metav1.ApplyOptions{
+			DryRun:       dryRun,
+			Force:        a.options.force,
+			FieldManager: client.DefaultFieldManager,
+		})
+		return err
+	}
+	_, err = resource.Create(a.ctx, obj, metav1.CreateOptions{DryRun: dryRun, FieldManager: client.DefaultFieldManager})
+	return err
+}
+
+// failure returns the status code and the localized message of a failed document.
+func (a *manifestApplier) failure(err error) (int, string) {
+	var httpErr *apperrors.HttpError
+	switch {
+	case errors.As(err, &httpErr):
+		// rejected before it was sent to the server
+	case meta.IsNoMatchError(err):
+		httpErr = apperrors.UnsupportedResourceKind
+	case applyConflicts(err) != nil:
+		httpErr = apperrors.ResourceApplyConflict
+	case apierrors.IsNotFound(err):
+		// the object itself cannot be missing on create
+		httpErr = apperrors.NamespaceNotFound
+	case !errors.As(apperrors.ResourceError(err), &httpErr):
+		return http.StatusInternalServerError, a.localize(msgkey.RequestFailed)
+	}
+	return httpErr.Code, a.localize(httpErr.Msg)
+}
@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/restmapper"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestSplitManifest(t *testing.T) {
+	cases := []struct {
+		name             string
+		data             string
+		expectedKinds    []string
+		expectedIsBundle bool
+	}{
+		{
+			"single document",
+			"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+			[]string{"ConfigMap"},
+			false,
+		},
+		{
+			"multiple documents",
+			"# app bundle\n---\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n---\n" +
+				"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: app\n---\n# trailing comment\n",
+			[]string{"Deployment", "Namespace"},
+			true,
+		},
+		{
+			"JSON List",
+			`{"apiVersion": "v1", "kind": "List", "items": [` +
+				`{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "web"}}]}`,
+			[]string{"Service"},
+			true,
+		},
+	}
+	for _, c := range cases {
+		objects, isBundle, err := splitManifest(c.data)
+		if err != nil {
+			t.Errorf("splitManifest(%s) failed: %v", c.name, err)
+			continue
+		}
+		kinds := make([]string, 0, len(objects))
+		for _, obj := range objects {
+			kinds = append(kinds, obj.GetKind())
+		}
+		if !reflect.DeepEqual(kinds, c.expectedKinds) || isBundle != c.expectedIsBundle {
+			t.Errorf("splitManifest(%s) == %#v, %v\nexpected %#v, %v\n", c.name, kinds, isBundle, c.expectedKinds, c.expectedIsBundle)
+		}
+	}
+
+	if _, _, err := splitManifest("---\n# nothing\n"); err == nil {
+		t.Errorf("splitManifest() of an empty manifest should fail")
+	}
+}
+
+func manifestObject(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"namespace": namespace, "name": name},
+	}}
+}
+
+// newTestApplier returns an applier creating objects with a fake dynamic client, the revisions are recorded
+// as <kind>/<name>.
+func newTestApplier(options mutationOptions, existing ...runtime.Object) (*manifestApplier, *dynamicfake.FakeDynamicClient, *[]string) {
+	fake := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace"},
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+			{Name: "pods", Kind: "Pod", Namespaced: true},
+		}},
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true},
+		}},
+	}}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), existing...)
+	revisions := &[]string{}
+	applier := &manifestApplier{
+		options:       options,
+		ctx:           context.TODO(),
+		dynamicClient: dynamicClient,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(fake)),
+		verber:        &fakeVerber{},
+		revisionRecorder: func(_ client.ResourceVerber, kind string, obj *unstructured.Unstructured, _, _ string) {
+			*revisions = append(*revisions, kind+"/"+obj.GetName())
+		},
+		localize: func(key string) string { return key },
+	}
+	return applier, dynamicClient, revisions
+}
+
+func createdNames(dynamicClient *dynamicfake.FakeDynamicClient) []string {
+	names := make([]string, 0)
+	for _, action := range dynamicClient.Actions() {
+		if create, ok := action.(clienttesting.CreateAction); ok {
+			names = append(names, create.GetObject().(*unstructured.Unstructured).GetName())
+		}
+	}
+	return names
+}
+
+func TestManifestApplierApply(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		manifestObject("apps/v1", "Deployment", "app", "web"),
+		manifestObject("v1", "Pod", "app", "debug"),
+		manifestObject("v1", "ConfigMap", "app", "web-config"),
+		manifestObject("v1", "Namespace", "", "app"),
+	}
+	applier, dynamicClient, revisions := newTestApplier(mutationOptions{})
+
+	result := applier.apply(objects, false)
+	// the namespace and the configuration are created before the workload, the results keep the manifest order
+	if actual := createdNames(dynamicClient); !reflect.DeepEqual(actual, []string{"app", "web-config", "web"}) {
+		t.Errorf("apply() created %v, expected [app web-config web]", actual)
+	}
+	outcomes := make([]string, 0, len(result.Results))
+	for _, document := range result.Results {
+		outcomes = append(outcomes, document.Name+"="+document.Outcome)
+	}
+	if !reflect.DeepEqual(outcomes, []string{"web=created", "debug=failed", "web-config=created", "app=created"}) {
+		t.Errorf("apply() outcomes == %v", outcomes)
+	}
+	// kinds outside the bundle allow-list are rejected before they are sent to the server
+	if pod := result.Results[1]; pod.Code != apperrors.UnsupportedResourceKind.Code || pod.Message != apperrors.UnsupportedResourceKind.Msg {
+		t.Errorf("apply() pod == %d %q, expected the unsupported kind error", pod.Code, pod.Message)
+	}
+	if result.Succeeded != 3 || result.Failed != 1 || result.Skipped != 0 {
+		t.Errorf("apply() counts == %d/%d/%d, expected 3/1/0", result.Succeeded, result.Failed, result.Skipped)
+	}
+	if !reflect.DeepEqual(*revisions, []string{"deployment/web"}) {
+		t.Errorf("apply() recorded revisions %v, expected [deployment/web]", *revisions)
+	}
+}
+
+func TestManifestApplierStopOnError(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		manifestObject("apps/v1", "Deployment", "app", "web"),
+		manifestObject("v1", "ConfigMap", "app", "web-config"),
+		manifestObject("v1", "Namespace", "", "app"),
+	}
+	applier, dynamicClient, revisions := newTestApplier(mutationOptions{}, manifestObject("v1", "ConfigMap", "app", "web-config"))
+
+	result := applier.apply(objects, true)
+	if actual := createdNames(dynamicClient); !reflect.DeepEqual(actual, []string{"app", "web-config"}) {
+		t.Errorf("apply() created %v, expected [app web-config]", actual)
+	}
+	web, config := result.Results[0], result.Results[1]
+	if config.Outcome != DocumentFailed || config.Code != http.StatusConflict {
+		t.Errorf("apply() web-config == %s %d, expected failed with conflict", config.Outcome, config.Code)
+	}
+	if web.Outcome != DocumentSkipped || web.Code != http.StatusFailedDependency {
+		t.Errorf("apply() web == %s %d, expected skipped after the failure", web.Outcome, web.Code)
+	}
+	if len(*revisions) != 0 {
+		t.Errorf("apply() recorded revisions %v, expected none", *revisions)
+	}
+
+	// a dry run records no revision
+	applier, _, revisions = newTestApplier(mutationOptions{dryRun: true})
+	if result := applier.apply(objects, true); result.Succeeded != 3 || len(*revisions) != 0 {
+		t.Errorf("apply() dry run == %d succeeded, %v revisions, expected 3 and none", result.Succeeded, *revisions)
+	}
+}
@@ -0,0 +1,135 @@
+package resources
+
+import (
+	"strings"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+)
+
+// Deletion of the policies that select only a deleted resource, by value of the policies query.
+const (
+	// PolicyDeletionOrphan leaves the policies behind
+	PolicyDeletionOrphan = "orphan"
+	// PolicyDeletionCascade deletes the policies with the resource
+	PolicyDeletionCascade = "cascade"
+)
+
+// overridePolicyKindMetas are the kinds of override policies, which have no permanent-id label on the resources.
+var overridePolicyKindMetas = []policyKindMeta{
+	{"overridepolicy", "", false},
+	{"clusteroverridepolicy", "", true},
+}
+
+// exclusivePolicies returns the policies that select only u: the propagation policies of the permanent-id labels of
+// u and the override policies, whose resource selectors all name u.
+func exclusivePolicies(verber client.ResourceVerber, u *unstructured.Unstructured) ([]v1.PolicyReference, error) {
+	policies := make([]v1.PolicyReference, 0)
+	labels := u.GetLabels()
+	for _, meta := range []policyKindMeta{ppKindMeta, cppKindMeta} {
+		permanentID, ok := labels[meta.LabelKey]
+		if !ok {
+			continue
+		}
+		candidates, err := verber.List(meta.Kind, policyNamespace(meta.IsClusterScope, u))
+		if err != nil {
+			return nil, err
+		}
+		for i := range candidates.Items {
+			policy := &candidates.Items[i]
+			if policy.GetLabels()[meta.LabelKey] == permanentID && selectsOnly(policy, u) {
+				policies = append(policies, toPolicyReference(policy))
+			}
+		}
+	}
+
+	for _, meta := range overridePolicyKindMetas {
+		candidates, err := verber.List(meta.Kind, policyNamespace(meta.IsClusterScope, u))
+		if err != nil {
+			return nil, err
+		}
+		for i := range candidates.Items {
+			if selectsOnly(&candidates.Items[i], u) {
+				policies = append(policies, toPolicyReference(&candidates.Items[i]))
+			}
+		}
+	}
+	return policies, nil
+}
+
+// policyNamespace returns the namespace of the policies that may select u.
+func policyNamespace(isClusterScope bool, u *unstructured.Unstructured) string {
+	if isClusterScope {
+		return ""
+	}
+	return u.GetNamespace()
+}
+
+// selectsOnly returns whether every resource selector of the policy names u, so that it cannot select another
+// resource. A policy without resource selectors selects every resource.
+func selectsOnly(policy, u *unstructured.Unstructured) bool {
+	selectors, _, err := unstructured.NestedSlice(policy.Object, "spec", "resourceSelectors")
+	if err != nil || len(selectors) == 0 {
+		return false
+	}
+	for _, s := range selectors {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		var selector policyv1alpha1.ResourceSelector
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &selector); err != nil {
+			return false
+		}
+		// the selectors of a namespaced policy select in its namespace
+		namespace := selector.Namespace
+		if namespace == "" && policy.GetNamespace() != "" {
+			namespace = policy.GetNamespace()
+		}
+		if selector.APIVersion != u.GetAPIVersion() || selector.Kind != u.GetKind() ||
+			selector.Name != u.GetName() || namespace != u.GetNamespace() {
+			return false
+		}
+	}
+	return true
+}
+
+func toPolicyReference(policy *unstructured.Unstructured) v1.PolicyReference {
+	return v1.PolicyReference{Kind: policy.GetKind(), Namespace: policy.GetNamespace(), Name: policy.GetName()}
+}
+
+// deleteResource deletes u and, on a cascade deletion, the policies that select only u, after u so that its
+// propagation is removed from the member clusters first. The policies are looked up before the deletion, which
+// removes the permanent-id labels.
+func deleteResource(verber client.ResourceVerber, kind string, u *unstructured.Unstructured, policyDeletion string) (*v1.ResourceDeletion, error) {
+	result := &v1.ResourceDeletion{PolicyDeletion: policyDeletion}
+	policies, err := exclusivePolicies(verber, u)
+	if err != nil {
+		if policyDeletion == PolicyDeletionCascade {
+			return nil, err
+		}
+		// the policies are only reported on an orphan deletion, they do not stop it
+		klog.ErrorS(err, "Failed to list policies of resource", "kind", kind, "namespace", u.GetNamespace(), "name", u.GetName())
+		policies = make([]v1.PolicyReference, 0)
+	}
+	result.Policies = policies
+
+	if err := verber.Delete(kind, u.GetNamespace(), u.GetName(), true); err != nil {
+		return nil, err
+	}
+	if policyDeletion != PolicyDeletionCascade {
+		return result, nil
+	}
+	for _, policy := range policies {
+		err := verber.Delete(strings.ToLower(policy.Kind), policy.Namespace, policy.Name, false)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return result, nil
+}
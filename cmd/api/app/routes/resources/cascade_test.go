@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeVerber gets and lists its objects by kind and namespace, and records the updates and deletions.
+type fakeVerber struct {
+	client.ResourceVerber
+	mu      sync.Mutex
+	objects []*unstructured.Unstructured
+	updated []*unstructured.Unstructured
+	deleted []string
+}
+
+func (v *fakeVerber) Get(kind string, namespace string, name string) (runtime.Object, error) {
+	for _, obj := range v.objects {
+		if strings.ToLower(obj.GetKind()) == kind && obj.GetNamespace() == namespace && obj.GetName() == name {
+			return obj.DeepCopy(), nil
+		}
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: kind}, name)
+}
+
+func (v *fakeVerber) Update(object *unstructured.Unstructured) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.updated = append(v.updated, object)
+	return nil
+}
+
+func (v *fakeVerber) List(kind string, namespace string) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	for _, obj := range v.objects {
+		if strings.ToLower(obj.GetKind()) == kind && (namespace == "" || obj.GetNamespace() == namespace) {
+			list.Items = append(list.Items, *obj)
+		}
+	}
+	return list, nil
+}
+
+func (v *fakeVerber) Delete(kind string, namespace string, name string, _ bool) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.deleted = append(v.deleted, kind+"/"+namespace+"/"+name)
+	return nil
+}
+
+func policy(kind, namespace, name string, labels map[string]interface{}, selectors ...map[string]interface{}) *unstructured.Unstructured {
+	resourceSelectors := make([]interface{}, 0, len(selectors))
+	for _, s := range selectors {
+		resourceSelectors = append(resourceSelectors, s)
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "policy.karmada.io/v1alpha1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"namespace": namespace, "name": name, "labels": labels},
+		"spec":       map[string]interface{}{"resourceSelectors": resourceSelectors},
+	}}
+}
+
+func TestDeleteResourceCascade(t *testing.T) {
+	web := map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"}
+	ppLabels := map[string]interface{}{ppKindMeta.LabelKey: "8b6a"}
+	template := deploymentTemplate("nginx:1.25", 2)
+	template.SetLabels(map[string]string{ppKindMeta.LabelKey: "8b6a"})
+	verber := &fakeVerber{objects: []*unstructured.Unstructured{
+		policy("PropagationPolicy", "default", "web-pp", ppLabels, web),
+		// shared with another deployment
+		policy("OverridePolicy", "default", "shared-op", nil, web, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "api"}),
+		policy("OverridePolicy", "default", "web-op", nil, web),
+		// selects every deployment of the namespace
+		policy("OverridePolicy", "default", "all-op", nil, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"}),
+		policy("ClusterOverridePolicy", "", "web-cop", nil, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "web"}),
+		policy("ClusterOverridePolicy", "", "other-cop", nil, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "prod", "name": "web"}),
+	}}
+
+	actual, err := deleteResource(verber, "deployment", template, PolicyDeletionCascade)
+	if err != nil {
+		t.Fatalf("deleteResource() failed: %v", err)
+	}
+	expected := &v1.ResourceDeletion{PolicyDeletion: PolicyDeletionCascade, Policies: []v1.PolicyReference{
+		{Kind: "PropagationPolicy", Namespace: "default", Name: "web-pp"},
+		{Kind: "OverridePolicy", Namespace: "default", Name: "web-op"},
+		{Kind: "ClusterOverridePolicy", Name: "web-cop"},
+	}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("deleteResource() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+	expectedDeleted := []string{"deployment/default/web", "propagationpolicy/default/web-pp", "overridepolicy/default/web-op", "clusteroverridepolicy//web-cop"}
+	if !reflect.DeepEqual(verber.deleted, expectedDeleted) {
+		t.Errorf("deleteResource() deleted %#v, expected %#v", verber.deleted, expectedDeleted)
+	}
+
+	// an orphan deletion reports the policies and leaves them behind
+	verber.deleted = nil
+	actual, err = deleteResource(verber, "deployment", template, PolicyDeletionOrphan)
+	if err != nil {
+		t.Fatalf("deleteResource() failed: %v", err)
+	}
+	if len(actual.Policies) != 3 || !reflect.DeepEqual(verber.deleted, []string{"deployment/default/web"}) {
+		t.Errorf("deleteResource(orphan) == %#v deleted %#v, expected only the deployment deleted", actual, verber.deleted)
+	}
+}
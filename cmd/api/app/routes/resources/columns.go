@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Columns of a resource that are also properties used to sort and filter the list.
+const (
+	ColumnStatus = string(dataselect.StatusProperty)
+	ColumnType   = string(dataselect.TypeProperty)
+)
+
+type columnsFunc func(u *unstructured.Unstructured) map[string]interface{}
+
+// kindColumns are the kind specific columns of the resources in the list, by kind in lower case.
+// Custom resources have the customResourceColumns.
+var kindColumns = map[string]columnsFunc{
+	"service":                 serviceColumns,
+	"ingress":                 ingressColumns,
+	"persistentvolumeclaim":   persistentVolumeClaimColumns,
+	"serviceaccount":          serviceAccountColumns,
+	"role":                    roleColumns,
+	"rolebinding":             roleBindingColumns,
+	"horizontalpodautoscaler": horizontalPodAutoscalerColumns,
+	"networkpolicy":           networkPolicyColumns,
+}
+
+func columnsOf(kind string, u *unstructured.Unstructured) map[string]interface{} {
+	if columns, ok := kindColumns[kind]; ok {
+		return columns(u)
+	}
+	if _, ok := supportedKinds[kind]; !ok {
+		return customResourceColumns(u)
+	}
+	return nil
+}
+
+func serviceColumns(u *unstructured.Unstructured) map[string]interface{} {
+	serviceType, _, _ := unstructured.NestedString(u.Object, "spec", "type")
+	if serviceType == "" {
+		serviceType = "ClusterIP"
+	}
+	clusterIP, _, _ := unstructured.NestedString(u.Object, "spec", "clusterIP")
+	externalIPs, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "externalIPs")
+
+	var ports []string
+	for _, port := range nestedMaps(u, "spec", "ports") {
+		number, _, _ := unstructured.NestedInt64(port, "port")
+		protocol, _, _ := unstructured.NestedString(port, "protocol")
+		if protocol == "" {
+			protocol = "TCP"
+		}
+		ports = append(ports, fmt.Sprintf("%d/%s", number, protocol))
+	}
+	return map[string]interface{}{
+		ColumnType:    serviceType,
+		"clusterIP":   clusterIP,
+		"externalIPs": append(externalIPs, loadBalancerAddresses(u)...),
+		"ports":       ports,
+	}
+}
+
+func ingressColumns(u *unstructured.Unstructured) map[string]interface{} {
+	class, _, _ := unstructured.NestedString(u.Object, "spec", "ingressClassName")
+	var hosts []string
+	for _, rule := range nestedMaps(u, "spec", "rules") {
+		if host, _, _ := unstructured.NestedString(rule, "host"); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return map[string]interface{}{
+		"class":     class,
+		"hosts":     hosts,
+		"addresses": loadBalancerAddresses(u),
+	}
+}
+
+func persistentVolumeClaimColumns(u *unstructured.Unstructured) map[string]interface{} {
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	volume, _, _ := unstructured.NestedString(u.Object, "spec", "volumeName")
+	capacity, _, _ := unstructured.NestedString(u.Object, "status", "capacity", "storage")
+	accessModes, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "accessModes")
+	storageClass, _, _ := unstructured.NestedString(u.Object, "spec", "storageClassName")
+	return map[string]interface{}{
+		ColumnStatus:   phase,
+		"volume":       volume,
+		"capacity":     capacity,
+		"accessModes":  accessModes,
+		"storageClass": storageClass,
+	}
+}
+
+func serviceAccountColumns(u *unstructured.Unstructured) map[string]interface{} {
+	return map[string]interface{}{
+		"secrets": len(nestedMaps(u, "secrets")),
+	}
+}
+
+func roleColumns(u *unstructured.Unstructured) map[string]interface{} {
+	return map[string]interface{}{
+		"rules": len(nestedMaps(u, "rules")),
+	}
+}
+
+func roleBindingColumns(u *unstructured.Unstructured) map[string]interface{} {
+	roleKind, _, _ := unstructured.NestedString(u.Object, "roleRef", "kind")
+	roleName, _, _ := unstructured.NestedString(u.Object, "roleRef", "name")
+	var subjects []string
+	for _, subject := range nestedMaps(u, "subjects") {
+		kind, _, _ := unstructured.NestedString(subject, "kind")
+		name, _, _ := unstructured.NestedString(subject, "name")
+		subjects = append(subjects, kind+"/"+name)
+	}
+	return map[string]interface{}{
+		ColumnType: roleKind,
+		"role":     roleKind + "/" + roleName,
+		"subjects": subjects,
+	}
+}
+
+func horizontalPodAutoscalerColumns(u *unstructured.Unstructured) map[string]interface{} {
+	targetKind, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "kind")
+	targetName, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+	minReplicas, found, _ := unstructured.NestedInt64(u.Object, "spec", "minReplicas")
+	if !found {
+		minReplicas = 1
+	}
+	maxReplicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "maxReplicas")
+	currentReplicas, _, _ := unstructured.NestedInt64(u.Object, "status", "currentReplicas")
+	desiredReplicas, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredReplicas")
+	return map[string]interface{}{
+		"reference":       targetKind + "/" + targetName,
+		"minReplicas":     minReplicas,
+		"maxReplicas":     maxReplicas,
+		"currentReplicas": currentReplicas,
+		"desiredReplicas": desiredReplicas,
+	}
+}
+
+func networkPolicyColumns(u *unstructured.Unstructured) map[string]interface{} {
+	podSelector := "<none>"
+	if selector, found, _ := unstructured.NestedMap(u.Object, "spec", "podSelector"); found {
+		var labelSelector metav1.LabelSelector
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selector, &labelSelector); err == nil {
+			podSelector = metav1.FormatLabelSelector(&labelSelector)
+		}
+	}
+	policyTypes, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "policyTypes")
+	return map[string]interface{}{
+		ColumnType:    strings.Join(policyTypes, ","),
+		"podSelector": podSelector,
+	}
+}
+
+// customResourceColumns has the status of the Ready condition of a custom resource, if it has one.
+func customResourceColumns(u *unstructured.Unstructured) map[string]interface{} {
+	for _, condition := range nestedMaps(u, "status", "conditions") {
+		if conditionType, _, _ := unstructured.NestedString(condition, "type"); conditionType != "Ready" {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		return map[string]interface{}{
+			ColumnStatus: status,
+			"reason":     reason,
+		}
+	}
+	return nil
+}
+
+// loadBalancerAddresses returns the IPs and hostnames of status.loadBalancer.ingress.
+func loadBalancerAddresses(u *unstructured.Unstructured) []string {
+	var addresses []string
+	for _, ingress := range nestedMaps(u, "status", "loadBalancer", "ingress") {
+		if ip, _, _ := unstructured.NestedString(ingress, "ip"); ip != "" {
+			addresses = append(addresses, ip)
+		} else if hostname, _, _ := unstructured.NestedString(ingress, "hostname"); hostname != "" {
+			addresses = append(addresses, hostname)
+		}
+	}
+	return addresses
+}
+
+// nestedMaps returns the objects of the list at fields, skipping the items that are not objects.
+func nestedMaps(u *unstructured.Unstructured, fields ...string) []map[string]interface{} {
+	items, _, _ := unstructured.NestedSlice(u.Object, fields...)
+	maps := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			maps = append(maps, m)
+		}
+	}
+	return maps
+}
@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestColumnsOf(t *testing.T) {
+	cases := []struct {
+		kind     string
+		object   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			"service",
+			map[string]interface{}{
+				"spec": map[string]interface{}{
+					"type":      "LoadBalancer",
+					"clusterIP": "10.0.0.10",
+					"ports": []interface{}{
+						map[string]interface{}{"port": int64(80)},
+						map[string]interface{}{"port": int64(53), "protocol": "UDP"},
+					},
+				},
+				"status": map[string]interface{}{
+					"loadBalancer": map[string]interface{}{
+						"ingress": []interface{}{map[string]interface{}{"hostname": "lb.example.com"}},
+					},
+				},
+			},
+			map[string]interface{}{
+				"type":        "LoadBalancer",
+				"clusterIP":   "10.0.0.10",
+				"externalIPs": []string{"lb.example.com"},
+				"ports":       []string{"80/TCP", "53/UDP"},
+			},
+		},
+		{
+			"rolebinding",
+			map[string]interface{}{
+				"roleRef": map[string]interface{}{"kind": "ClusterRole", "name": "view"},
+				"subjects": []interface{}{
+					map[string]interface{}{"kind": "User", "name": "alice"},
+				},
+			},
+			map[string]interface{}{
+				"type":     "ClusterRole",
+				"role":     "ClusterRole/view",
+				"subjects": []string{"User/alice"},
+			},
+		},
+		{
+			"networkpolicy",
+			map[string]interface{}{
+				"spec": map[string]interface{}{
+					"podSelector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+					"policyTypes": []interface{}{"Ingress", "Egress"},
+				},
+			},
+			map[string]interface{}{
+				"type":        "Ingress,Egress",
+				"podSelector": "app=web",
+			},
+		},
+		{
+			"crontabs.stable.example.com",
+			map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Synced", "status": "True"},
+						map[string]interface{}{"type": "Ready", "status": "False", "reason": "Pending"},
+					},
+				},
+			},
+			map[string]interface{}{
+				"status": "False",
+				"reason": "Pending",
+			},
+		},
+		{
+			"deployment",
+			map[string]interface{}{},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		actual := columnsOf(c.kind, &unstructured.Unstructured{Object: c.object})
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("columnsOf(%#v) == \n%#v\nexpected \n%#v\n", c.kind, actual, c.expected)
+		}
+	}
+}
+
+func TestResourceCellColumnProperties(t *testing.T) {
+	cell := ResourceCell{Columns: map[string]interface{}{ColumnStatus: "Bound", "capacity": "1Gi"}}
+	if actual := cell.GetProperty(dataselect.StatusProperty); actual != dataselect.StdComparableString("Bound") {
+		t.Errorf("GetProperty(status) == %#v, expected %#v", actual, "Bound")
+	}
+	if actual := cell.GetProperty(dataselect.TypeProperty); actual != nil {
+		t.Errorf("GetProperty(type) == %#v, expected nil", actual)
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 type ResourceCell struct {
 	unstructured.Unstructured
+	// Columns are the kind specific columns of the resource
+	Columns map[string]interface{}
 }
 
 // GetProperty returns the given property of the Resource
@@ -18,24 +20,29 @@ func (c ResourceCell) GetProperty(name dataselect.PropertyName) dataselect.Compa
 		return dataselect.StdComparableTime(c.GetCreationTimestamp().Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(c.GetNamespace())
+	case dataselect.StatusProperty, dataselect.TypeProperty:
+		if value, ok := c.Columns[string(name)].(string); ok {
+			return dataselect.StdComparableString(value)
+		}
+		return nil
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
 	}
 }
 
-func toCells(std []unstructured.Unstructured) []dataselect.DataCell {
+func toCells(kind string, std []unstructured.Unstructured) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
-		cells[i] = ResourceCell{Unstructured: std[i]}
+		cells[i] = ResourceCell{Unstructured: std[i], Columns: columnsOf(kind, &std[i])}
 	}
 	return cells
 }
 
-func fromCells(cells []dataselect.DataCell) []unstructured.Unstructured {
-	std := make([]unstructured.Unstructured, len(cells))
+func fromCells(cells []dataselect.DataCell) []ResourceCell {
+	std := make([]ResourceCell, len(cells))
 	for i := range std {
-		std[i] = cells[i].(ResourceCell).Unstructured
+		std[i] = cells[i].(ResourceCell)
 	}
 	return std
 }
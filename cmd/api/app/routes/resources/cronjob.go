@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
+	"github.com/karmada-io/dashboard/pkg/resource/job"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// cronJobInstantiateAnnotation marks the Jobs created by triggering a CronJob manually.
+const cronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// cronJobTargetClusters returns the clusters, all the target clusters of the propagation if empty. The clusters
+// must be target clusters, as the CronJob only exists in these clusters.
+func cronJobTargetClusters(propagation *v1.PropagationStatus, clusters []string) ([]string, error) {
+	if propagation == nil || len(propagation.Clusters) == 0 {
+		return nil, apperrors.ResourceNotPropagated
+	}
+	targets := make([]string, 0, len(propagation.Clusters))
+	for _, cluster := range propagation.Clusters {
+		targets = append(targets, cluster.ClusterName)
+	}
+	if len(clusters) == 0 {
+		return targets, nil
+	}
+	for _, cluster := range clusters {
+		if !slices.Contains(targets, cluster) {
+			return nil, apperrors.RequestValueInvalid
+		}
+	}
+	return clusters, nil
+}
+
+// suspendTemplate sets the suspension of the CronJob template. Karmada propagates the change to every target
+// cluster, where the CronJob controller stops or resumes scheduling Jobs; running Jobs are not stopped.
+func suspendTemplate(verber resourceUpdater, template *unstructured.Unstructured, suspend bool) error {
+	suspended := template.DeepCopy()
+	if err := unstructured.SetNestedField(suspended.Object, suspend, "spec", "suspend"); err != nil {
+		return err
+	}
+	return verber.Update(suspended)
+}
+
+type cronJobOperator struct {
+	memberClient func(clusterName string) kubeclient.Interface
+	// dryRun validates the Jobs of a trigger in the member clusters without creating them
+	dryRun bool
+}
+
+// trigger creates a Job from the CronJob in each cluster concurrently. The errors are reported by cluster,
+// so that a cluster that cannot be reached does not stop the trigger in the other clusters.
+func (o *cronJobOperator) trigger(namespace, name string, clusterNames []string) *v1.CronJobTriggerResult {
+	result := &v1.CronJobTriggerResult{Clusters: make([]v1.ClusterJobTrigger, len(clusterNames))}
+	var opts metav1.CreateOptions
+	if o.dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	var wg sync.WaitGroup
+	for i, clusterName := range clusterNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			triggered := v1.ClusterJobTrigger{ClusterName: clusterName}
+			memberClient := o.memberClient(clusterName)
+			if memberClient == nil {
+				triggered.Error = fmt.Sprintf("no client for member cluster %s", clusterName)
+			} else if created, err := cronjob.TriggerCronJobWithOptions(memberClient, namespace, name, opts); err != nil {
+				klog.ErrorS(err, "Failed to trigger cronjob", "cluster", clusterName, "namespace", namespace, "name", name)
+				triggered.Error = err.Error()
+			} else {
+				triggered.JobName = created.Name
+			}
+			result.Clusters[i] = triggered
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+// jobs reads the CronJob and its Jobs in each cluster concurrently.
+func (o *cronJobOperator) jobs(namespace, name string, clusterNames []string) *v1.CronJobJobList {
+	result := &v1.CronJobJobList{Clusters: make([]v1.ClusterCronJobJobs, len(clusterNames))}
+	var wg sync.WaitGroup
+	for i, clusterName := range clusterNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result.Clusters[i] = o.clusterJobs(namespace, name, clusterName)
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+func (o *cronJobOperator) clusterJobs(namespace, name, clusterName string) v1.ClusterCronJobJobs {
+	result := v1.ClusterCronJobJobs{ClusterName: clusterName, Jobs: make([]v1.CronJobJob, 0)}
+	memberClient := o.memberClient(clusterName)
+	if memberClient == nil {
+		result.Error = fmt.Sprintf("no client for member cluster %s", clusterName)
+		return result
+	}
+	cronJob, err := memberClient.BatchV1().CronJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to get cronjob", "cluster", clusterName, "namespace", namespace, "name", name)
+		result.Error = err.Error()
+		return result
+	}
+	result.Suspended = cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+	result.LastScheduleTime = cronJob.Status.LastScheduleTime
+
+	jobs, err := memberClient.BatchV1().Jobs(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to list jobs", "cluster", clusterName, "namespace", namespace)
+		result.Error = err.Error()
+		return result
+	}
+	for i := range jobs.Items {
+		if isOwnedBy(&jobs.Items[i], cronJob) {
+			result.Jobs = append(result.Jobs, toCronJobJob(&jobs.Items[i]))
+		}
+	}
+	sort.SliceStable(result.Jobs, func(i, j int) bool {
+		return result.Jobs[i].CreatedAt.After(result.Jobs[j].CreatedAt.Time)
+	})
+	return result
+}
+
+func isOwnedBy(j *batchv1.Job, cronJob *batchv1.CronJob) bool {
+	for _, owner := range j.OwnerReferences {
+		if owner.UID == cronJob.UID {
+			return true
+		}
+	}
+	return false
+}
+
+func toCronJobJob(j *batchv1.Job) v1.CronJobJob {
+	return v1.CronJobJob{
+		Name:           j.Name,
+		CreatedAt:      j.CreationTimestamp,
+		State:          string(jobState(j)),
+		Manual:         j.Annotations[cronJobInstantiateAnnotation] == "manual",
+		Active:         j.Status.Active,
+		Succeeded:      j.Status.Succeeded,
+		Failed:         j.Status.Failed,
+		StartTime:      j.Status.StartTime,
+		CompletionTime: j.Status.CompletionTime,
+	}
+}
+
+// jobState returns the completion state of the Job from its conditions.
+func jobState(j *batchv1.Job) job.JobStatusType {
+	for _, condition := range j.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return job.JobStatusComplete
+		case batchv1.JobFailed:
+			return job.JobStatusFailed
+		}
+	}
+	return job.JobStatusRunning
+}
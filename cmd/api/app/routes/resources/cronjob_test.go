@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubeclient "k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCronJobTargetClusters(t *testing.T) {
+	propagation := &v1.PropagationStatus{Clusters: []v1.ClusterPropagation{{ClusterName: "member1"}, {ClusterName: "member2"}}}
+	cases := []struct {
+		propagation *v1.PropagationStatus
+		clusters    []string
+		expected    []string
+		err         error
+	}{
+		{propagation, nil, []string{"member1", "member2"}, nil},
+		{propagation, []string{"member2"}, []string{"member2"}, nil},
+		{propagation, []string{"member3"}, nil, apperrors.RequestValueInvalid},
+		{nil, nil, nil, apperrors.ResourceNotPropagated},
+	}
+	for _, c := range cases {
+		actual, err := cronJobTargetClusters(c.propagation, c.clusters)
+		if err != c.err || !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("cronJobTargetClusters(%#v) == %#v, %v expected %#v, %v", c.clusters, actual, err, c.expected, c.err)
+		}
+	}
+}
+
+func TestCronJobOperator(t *testing.T) {
+	suspended := true
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report", UID: "4f1c2a9e"},
+		Spec:       batchv1.CronJobSpec{Suspend: &suspended},
+	}
+	owner := []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: "report", UID: "4f1c2a9e"}}
+	now := time.Now()
+	members := map[string]kubeclient.Interface{
+		"member1": kubefake.NewSimpleClientset(
+			cronJob,
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report-1", OwnerReferences: owner, CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+				Status: batchv1.JobStatus{
+					Succeeded:  1,
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+				},
+			},
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report-2", OwnerReferences: owner, CreationTimestamp: metav1.NewTime(now)},
+				Status: batchv1.JobStatus{
+					Failed:     2,
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+				},
+			},
+			&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"}},
+		),
+		"member2": kubefake.NewSimpleClientset(),
+	}
+	operator := &cronJobOperator{memberClient: func(clusterName string) kubeclient.Interface {
+		return members[clusterName]
+	}}
+
+	triggered := operator.trigger("default", "report", []string{"member1", "member2", "member3"})
+	if len(triggered.Clusters) != 3 || !strings.HasPrefix(triggered.Clusters[0].JobName, "report-manual-") ||
+		triggered.Clusters[1].Error == "" || triggered.Clusters[2].Error == "" {
+		t.Errorf("trigger() == %#v, expected a job in member1 and errors in member2 and member3", triggered.Clusters)
+	}
+
+	jobs := operator.jobs("default", "report", []string{"member1", "member2"})
+	member1 := jobs.Clusters[0]
+	if !member1.Suspended || member1.Error != "" || len(member1.Jobs) != 3 {
+		t.Fatalf("jobs() member1 == %#v, expected 3 jobs of the suspended cronjob", member1)
+	}
+	states := make([]string, 0, len(member1.Jobs))
+	for _, j := range member1.Jobs {
+		states = append(states, j.Name+"="+j.State)
+	}
+	// the triggered job has no creation timestamp in the fake client and is the oldest
+	expected := []string{"report-2=Failed", "report-1=Complete", triggered.Clusters[0].JobName + "=Running"}
+	if !reflect.DeepEqual(states, expected) || !member1.Jobs[2].Manual {
+		t.Errorf("jobs() member1 jobs == %#v, expected %#v with the manual job last", states, expected)
+	}
+	if jobs.Clusters[1].Error == "" {
+		t.Errorf("jobs() member2 == %#v, expected an error", jobs.Clusters[1])
+	}
+}
+
+func TestCronJobOperatorTriggerDryRun(t *testing.T) {
+	memberClient := kubefake.NewSimpleClientset(&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "report"}})
+	operator := &cronJobOperator{memberClient: func(string) kubeclient.Interface { return memberClient }, dryRun: true}
+
+	triggered := operator.trigger("default", "report", []string{"member1"})
+	if triggered.Clusters[0].Error != "" {
+		t.Fatalf("trigger(dryRun) == %#v, expected no error", triggered.Clusters[0])
+	}
+	created := 0
+	for _, action := range memberClient.Actions() {
+		if create, ok := action.(k8stesting.CreateActionImpl); ok {
+			created++
+			if options := create.GetCreateOptions(); !reflect.DeepEqual(options.DryRun, []string{metav1.DryRunAll}) {
+				t.Errorf("trigger(dryRun) created the job with %#v, expected a dry run", options)
+			}
+		}
+	}
+	if created != 1 {
+		t.Errorf("trigger(dryRun) created %d jobs, expected 1", created)
+	}
+}
+
+func TestSuspendTemplate(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "report"},
+		"spec":       map[string]interface{}{"schedule": "0 * * * *"},
+	}}
+	updater := &fakeUpdater{}
+	if err := suspendTemplate(updater, template, true); err != nil {
+		t.Fatalf("suspendTemplate() failed: %v", err)
+	}
+	if suspend, _, _ := unstructured.NestedBool(updater.updated.Object, "spec", "suspend"); !suspend {
+		t.Errorf("suspendTemplate() updated \n%#v\nexpected suspend", updater.updated)
+	}
+
+	// suspending does not change the revision
+	before, _ := revisionData(template)
+	after, _ := revisionData(updater.updated)
+	if !sameRevisionData(before, after) {
+		t.Errorf("revisionData() == %s after suspend, expected %s", after, before)
+	}
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"strings"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+const crdKind = "customresourcedefinition"
+
+// customResourceDefinition is the part of a CustomResourceDefinition used to check the kinds of custom resources.
+type customResourceDefinition struct {
+	group  string
+	kind   string
+	plural string
+	scope  string
+}
+
+func toCustomResourceDefinition(u *unstructured.Unstructured) customResourceDefinition {
+	var crd customResourceDefinition
+	crd.group, _, _ = unstructured.NestedString(u.Object, "spec", "group")
+	crd.kind, _, _ = unstructured.NestedString(u.Object, "spec", "names", "kind")
+	crd.plural, _, _ = unstructured.NestedString(u.Object, "spec", "names", "plural")
+	crd.scope, _, _ = unstructured.NestedString(u.Object, "spec", "scope")
+	return crd
+}
+
+// isManaged returns whether the custom resources of crd can be managed by the resources API.
+// Only namespaced custom resources are supported, and the Karmada APIs have their own routes.
+func (crd customResourceDefinition) isManaged() bool {
+	if crd.scope != "Namespaced" {
+		return false
+	}
+	return crd.group != "karmada.io" && !strings.HasSuffix(crd.group, ".karmada.io")
+}
+
+// pathKind returns the kind of the custom resources in the resource paths, <plural>.<group>.
+func (crd customResourceDefinition) pathKind() string {
+	return crd.plural + "." + crd.group
+}
+
+// findCustomResourceDefinition returns the first managed CustomResourceDefinition that matches.
+func findCustomResourceDefinition(verber client.ResourceVerber, match func(crd customResourceDefinition) bool) (*customResourceDefinition, error) {
+	crds, err := verber.List(crdKind, "")
+	if err != nil {
+		klog.ErrorS(err, "Failed to list custom resource definitions")
+		return nil, err
+	}
+	for i := range crds.Items {
+		crd := toCustomResourceDefinition(&crds.Items[i])
+		if crd.isManaged() && match(crd) {
+			return &crd, nil
+		}
+	}
+	return nil, nil
+}
+
+// validatePathKind checks the kind of a path parameter, either a supported kind or <plural>.<group> of a custom resource.
+func validatePathKind(verber client.ResourceVerber, kind string) error {
+	if IsKindSupportedForPathParam(kind) {
+		return nil
+	}
+	plural, group, ok := strings.Cut(strings.ToLower(kind), ".")
+	if !ok {
+		return apperrors.UnsupportedResourceKind
+	}
+	crd, err := findCustomResourceDefinition(verber, func(crd customResourceDefinition) bool {
+		return crd.plural == plural && crd.group == group
+	})
+	if err != nil {
+		return apperrors.ResourceError(err)
+	}
+	if crd == nil {
+		return apperrors.UnsupportedResourceKind
+	}
+	return nil
+}
+
+// validateManifestKind checks the kind of a manifest, either a supported kind or the kind of a custom resource.
+// It returns the kind used to get the resource with the verber.
+func validateManifestKind(verber client.ResourceVerber, gvk schema.GroupVersionKind) (string, error) {
+	if IsKindStrictlySupported(gvk.Kind) {
+		return strings.ToLower(gvk.Kind), nil
+	}
+	crd, err := findCustomResourceDefinition(verber, func(crd customResourceDefinition) bool {
+		return crd.kind == gvk.Kind && crd.group == gvk.Group
+	})
+	if err != nil {
+		return "", apperrors.ResourceError(err)
+	}
+	if crd == nil {
+		return "", apperrors.UnsupportedResourceKind
+	}
+	return crd.pathKind(), nil
+}
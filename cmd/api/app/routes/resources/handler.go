@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"errors"
+	"io"
+
 	"github.com/gin-gonic/gin"
 	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
 	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
@@ -8,6 +11,7 @@ import (
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
 	query "github.com/karmada-io/dashboard/pkg/resource/common"
 	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
 	"github.com/karmada-io/dashboard/pkg/resource/daemonset"
@@ -19,7 +23,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -135,16 +141,50 @@ func HandleGetResourceNamespace(c *gin.Context) {
 
 // ------------------------ Create / Update ------------------------
 
+// HandleCreateResource creates the resource of a manifest. A manifest with several documents or a List
+// is applied as a bundle of resources of any kind, with a result per resource.
+// dryRun=All validates the request without persisting it, serverSideApply and force apply the manifest
+// with the field manager of the dashboard.
 func HandleCreateResource(c *gin.Context) {
-	handleVerberActionWithManifest(c, false, func(verber client.ResourceVerber, obj *unstructured.Unstructured) error {
-		_, err := verber.Create(obj)
-		return err
+	options, err := parseMutationOptions(c)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	manifest, err := bindManifest(c)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	objects, isBundle, err := splitManifest(manifest.Data)
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse manifest")
+		response.FailedWithError(c, err)
+		return
+	}
+	if isBundle {
+		handleManifestBundle(c, objects, manifest.StopOnError, options)
+		return
+	}
+
+	handleVerberActionWithManifest(c, manifest, options, false, func(verber client.ResourceVerber, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		return verber.Create(obj)
 	}, response.Created)
 }
 
 func HandleUpdateResource(c *gin.Context) {
-	handleVerberActionWithManifest(c, true, func(verber client.ResourceVerber, obj *unstructured.Unstructured) error {
-		return verber.Update(obj)
+	options, err := parseMutationOptions(c)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	manifest, err := bindManifest(c)
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	handleVerberActionWithManifest(c, manifest, options, true, func(verber client.ResourceVerber, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		return nil, verber.Update(obj)
 	}, func(c *gin.Context) {
 		response.SuccessWithMessage(c, msgkey.ResourceUpdateSuccess)
 	})
@@ -153,8 +193,17 @@ func HandleUpdateResource(c *gin.Context) {
 // ------------------------ Get / Delete ------------------------
 
 func HandleGetResourceYaml(c *gin.Context) {
-	handleVerberActionWithPathParam(c, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
-		return verber.Get(kind, ns, name)
+	var policy *v1.PolicyMeta
+	var propagation *v1.PropagationStatus
+	var aggregatedStatus *v1.AggregatedWorkloadStatus
+	handleVerberActionWithPathParam(c, mutationOptions{}, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		obj, err := verber.Get(kind, ns, name)
+		if u, ok := obj.(*unstructured.Unstructured); ok && err == nil {
+			policy = resourcePolicyMeta(verber, u)
+			propagation = resourcePropagationStatus(u)
+			aggregatedStatus = resourceAggregatedStatus(u, propagation)
+		}
+		return obj, err
 	}, func(c *gin.Context, obj interface{}) {
 		u, ok := obj.(*unstructured.Unstructured)
 		if !ok {
@@ -169,21 +218,43 @@ func HandleGetResourceYaml(c *gin.Context) {
 		}
 
 		result := &v1.ResourceYaml{
-			Namespace: u.GetNamespace(),
-			Name:      u.GetName(),
-			UID:       u.GetUID(),
-			Yaml:      yamlStr,
+			Namespace:        u.GetNamespace(),
+			Name:             u.GetName(),
+			UID:              u.GetUID(),
+			Yaml:             yamlStr,
+			Policy:           policy,
+			Propagation:      propagation,
+			AggregatedStatus: aggregatedStatus,
 		}
 		response.Success(c, result)
 	})
 }
 
+// HandleDeleteResource deletes a resource. With policies=cascade, the propagation and override policies that select
+// only the resource are deleted too; the response has these policies, which a dry run previews.
 func HandleDeleteResource(c *gin.Context) {
-	handleVerberActionWithPathParam(c, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
-		err := verber.Delete(kind, ns, name, true)
-		return nil, err
-	}, func(c *gin.Context, _ interface{}) {
-		response.SuccessWithMessage(c, msgkey.ResourceDeletionCompleted)
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	policyDeletion := c.DefaultQuery("policies", PolicyDeletionOrphan)
+	if policyDeletion != PolicyDeletionOrphan && policyDeletion != PolicyDeletionCascade {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		return deleteResource(verber, kind, obj.(*unstructured.Unstructured), policyDeletion)
+	}, func(c *gin.Context, result interface{}) {
+		if options.dryRun {
+			response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+			return
+		}
+		response.SuccessWithData(c, msgkey.ResourceDeletionCompleted, result)
 	})
 }
 
@@ -194,12 +265,6 @@ func HandleListResource(c *gin.Context) {
 		return
 	}
 
-	kind := c.Param("kind")
-	if !IsKindSupportedForPathParam(kind) {
-		response.FailedWithError(c, apperrors.UnsupportedResourceKind)
-		return
-	}
-
 	verber, err := client.VerberClient(c.Request)
 	if err != nil {
 		klog.ErrorS(err, "Failed to init verber client")
@@ -207,6 +272,12 @@ func HandleListResource(c *gin.Context) {
 		return
 	}
 
+	kind := c.Param("kind")
+	if err := validatePathKind(verber, kind); err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+
 	namespace := common.ParseNamespaceQuery(c)
 	result, err := GetResourceList(verber, strings.ToLower(kind), namespace, dataSelect)
 	if err != nil {
@@ -215,3 +286,267 @@ func HandleListResource(c *gin.Context) {
 	}
 	response.Success(c, result)
 }
+
+// ------------------------ Revisions ------------------------
+
+// HandleGetResourceRevisions lists the recorded revisions of a workload template, each with its diff from the previous one.
+func HandleGetResourceRevisions(c *gin.Context) {
+	handleVerberActionWithPathParam(c, mutationOptions{}, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if !revisionKinds[kind] {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		template := obj.(*unstructured.Unstructured)
+		revisions, err := newRevisionStore().list(template)
+		if err != nil {
+			return nil, err
+		}
+		return toTemplateRevisionList(template, revisions)
+	}, func(c *gin.Context, result interface{}) {
+		response.Success(c, result)
+	})
+}
+
+// HandleRollbackResource restores the spec of a workload template from the revision of the revision query,
+// Karmada then propagates it again to all the target clusters.
+func HandleRollbackResource(c *gin.Context) {
+	revision, err := strconv.ParseInt(c.Query("revision"), 10, 64)
+	if err != nil || revision <= 0 {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	triggeredBy := userID(c)
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if !revisionKinds[kind] {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		template := obj.(*unstructured.Unstructured)
+		result, err := newRevisionStore().rollback(verber, kind, template, revision, triggeredBy, options.dryRun)
+		if err != nil {
+			return nil, err
+		}
+		result.Propagation = resourcePropagationStatus(template)
+		return result, nil
+	}, func(c *gin.Context, result interface{}) {
+		if options.dryRun {
+			response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+			return
+		}
+		response.SuccessWithData(c, msgkey.ResourceRollbackSuccess, result)
+	})
+}
+
+// ------------------------ Scale / Restart ------------------------
+
+// HandleScaleResource sets the replicas of a scalable workload template. The response has the split of the replicas
+// across the target clusters, as scheduled by Karmada with the replica scheduling of the placement.
+func HandleScaleResource(c *gin.Context) {
+	var request v1.ScaleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if !types.ResourceKind(kind).Scalable() {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		return scaleTemplate(c.Request.Context(), client.InClusterKarmadaClient(), verber, obj.(*unstructured.Unstructured), *request.Replicas, options.dryRun)
+	}, func(c *gin.Context, result interface{}) {
+		if options.dryRun {
+			response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+			return
+		}
+		response.SuccessWithData(c, msgkey.ResourceScaleSuccess, result)
+	})
+}
+
+// HandleRestartResource restarts a restartable workload template in all its target clusters.
+func HandleRestartResource(c *gin.Context) {
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	triggeredBy := userID(c)
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if !types.ResourceKind(kind).Restartable() {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		template := obj.(*unstructured.Unstructured)
+		restartedAt, err := restartTemplate(verber, template, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if !options.dryRun && revisionKinds[kind] {
+			recordRevision(verber, kind, template, RevisionCauseRestart, triggeredBy)
+		}
+		return &v1.RestartResult{RestartedAt: restartedAt, Propagation: resourcePropagationStatus(template)}, nil
+	}, func(c *gin.Context, result interface{}) {
+		if options.dryRun {
+			response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+			return
+		}
+		response.SuccessWithData(c, msgkey.ResourceRestartSuccess, result)
+	})
+}
+
+// cronJobKind is the path kind of the CronJob operations.
+const cronJobKind = "cronjob"
+
+// HandleTriggerCronJob runs a CronJob template now, by creating a Job from the CronJob of each target cluster of the
+// request, all the target clusters if none.
+func HandleTriggerCronJob(c *gin.Context) {
+	var request v1.CronJobTriggerRequest
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if kind != cronJobKind {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		clusterNames, err := cronJobTargetClusters(resourcePropagationStatus(obj.(*unstructured.Unstructured)), request.Clusters)
+		if err != nil {
+			return nil, err
+		}
+		operator := &cronJobOperator{memberClient: client.InClusterClientForMemberCluster, dryRun: options.dryRun}
+		return operator.trigger(ns, name, clusterNames), nil
+	}, func(c *gin.Context, result interface{}) {
+		if options.dryRun {
+			response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+			return
+		}
+		response.SuccessWithData(c, msgkey.CronJobTriggerSuccess, result)
+	})
+}
+
+// HandleSuspendCronJob suspends a CronJob template, which stops the scheduling of Jobs in all its target clusters.
+func HandleSuspendCronJob(c *gin.Context) {
+	handleSuspendCronJob(c, true, msgkey.CronJobSuspendSuccess)
+}
+
+// HandleResumeCronJob resumes a suspended CronJob template in all its target clusters.
+func HandleResumeCronJob(c *gin.Context) {
+	handleSuspendCronJob(c, false, msgkey.CronJobResumeSuccess)
+}
+
+func handleSuspendCronJob(c *gin.Context, suspend bool, msg string) {
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	if options.dryRun {
+		msg = msgkey.ResourceDryRunSuccess
+	}
+	handleVerberActionWithPathParam(c, options, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if kind != cronJobKind {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		template := obj.(*unstructured.Unstructured)
+		if err := suspendTemplate(verber, template, suspend); err != nil {
+			return nil, err
+		}
+		return &v1.CronJobSuspendResult{Suspended: suspend, Propagation: resourcePropagationStatus(template)}, nil
+	}, func(c *gin.Context, result interface{}) {
+		response.SuccessWithData(c, msg, result)
+	})
+}
+
+// HandleGetCronJobJobs lists the Jobs of a CronJob template in each of its target clusters with their completion state.
+func HandleGetCronJobJobs(c *gin.Context) {
+	handleVerberActionWithPathParam(c, mutationOptions{}, func(verber client.ResourceVerber, kind, ns, name string) (interface{}, error) {
+		if kind != cronJobKind {
+			return nil, apperrors.UnsupportedResourceKind
+		}
+		obj, err := verber.Get(kind, ns, name)
+		if err != nil {
+			return nil, err
+		}
+		clusterNames, err := cronJobTargetClusters(resourcePropagationStatus(obj.(*unstructured.Unstructured)), nil)
+		if err != nil {
+			return nil, err
+		}
+		operator := &cronJobOperator{memberClient: client.InClusterClientForMemberCluster}
+		return operator.jobs(ns, name, clusterNames), nil
+	}, func(c *gin.Context, result interface{}) {
+		response.Success(c, result)
+	})
+}
+
+// HandleBulkResources runs one action on several resources, referenced or selected by labels. The response has the
+// result of each resource, and dryRun=All validates the action on every resource without persisting it.
+func HandleBulkResources(c *gin.Context) {
+	options, err := parseMutationOptions(c)
+	if err != nil || options.serverSideApply {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	var request v1.BulkRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	if err := validateBulkRequest(&request); err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+
+	verber, err := client.VerberClientWithOptions(c.Request, client.VerberOptions{DryRun: options.dryRun})
+	if err != nil {
+		klog.ErrorS(err, "Failed to init verber client")
+		response.FailedWithError(c, err)
+		return
+	}
+	operation := &bulkOperation{
+		verber:      verber,
+		request:     &request,
+		dryRun:      options.dryRun,
+		triggeredBy: userID(c),
+		now:         time.Now(),
+	}
+	result, err := operation.run()
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+	response.SuccessWithData(c, msgkey.BulkOperationCompleted, result)
+}
@@ -31,23 +31,31 @@ func GetResourceList(verber client.ResourceVerber, kind string, nsQuery *common.
 		klog.ErrorS(err, "Failed to get resource list", "namespace", nsQuery.ToRequestParam(), "kind", kind)
 		return nil, apperrors.ResourceError(err)
 	}
-	return toResourceList(verber, list.Items, dsQuery), nil
+	return toResourceList(verber, kind, nsQuery.ToRequestParam(), list.Items, dsQuery), nil
 }
 
-func toResourceList(verber client.ResourceVerber, unstructured []unstructured.Unstructured, dsQuery *dataselect.DataSelectQuery) *v1.ResourceList {
+func toResourceList(verber client.ResourceVerber, kind, namespace string, unstructured []unstructured.Unstructured, dsQuery *dataselect.DataSelectQuery) *v1.ResourceList {
 	resourceList := &v1.ResourceList{
 		Resources: make([]v1.Resource, 0),
 		ListMeta:  types.ListMeta{TotalItems: len(unstructured)},
 	}
-	resourceCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(unstructured), dsQuery)
-	unstructured = fromCells(resourceCells)
+	resourceCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(kind, unstructured), dsQuery)
 	resourceList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
 
-	for _, u := range unstructured {
-		resourceList.Resources = append(resourceList.Resources, toResource(&u))
+	propagations, err := listPropagationSummaries(client.InClusterKarmadaClient(), namespace)
+	if err != nil {
+		klog.ErrorS(err, "failed to list resource bindings", "namespace", namespace)
+	}
+	for _, cell := range fromCells(resourceCells) {
+		resource := toResource(&cell.Unstructured)
+		resource.Columns = cell.Columns
+		if propagation, ok := propagations[bindingKey(cell.GetAPIVersion(), cell.GetKind(), cell.GetNamespace(), cell.GetName())]; ok {
+			resource.Propagation = &propagation
+		}
+		resourceList.Resources = append(resourceList.Resources, resource)
 	}
 
-	err := AttachPolicyMetaToResources(verber, resourceList.Resources)
+	err = AttachPolicyMetaToResources(verber, resourceList.Resources)
 	if err != nil {
 		klog.ErrorS(err, "failed to attach policyMeta to resources")
 	}
@@ -66,6 +74,19 @@ func toResource(u *unstructured.Unstructured) v1.Resource {
 	}
 }
 
+// resourcePolicyMeta returns the policy propagating u, or nil if it is not propagated or the policies cannot be listed.
+func resourcePolicyMeta(verber client.ResourceVerber, u *unstructured.Unstructured) *v1.PolicyMeta {
+	resources := []v1.Resource{toResource(u)}
+	if err := AttachPolicyMetaToResources(verber, resources); err != nil {
+		klog.ErrorS(err, "failed to attach policyMeta to resource", "namespace", u.GetNamespace(), "name", u.GetName())
+		return nil
+	}
+	if resources[0].Policy.Name == "" {
+		return nil
+	}
+	return &resources[0].Policy
+}
+
 func AttachPolicyMetaToResources(verber client.ResourceVerber, resourceList []v1.Resource) error {
 	ppMap, err := BuildPolicyMetaMapByPermanentId(verber, ppKindMeta)
 	if err != nil {
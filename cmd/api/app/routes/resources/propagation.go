@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"context"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// getPropagationStatus returns the propagation of u from its ResourceBinding, or its ClusterResourceBinding if u is
+// cluster scoped. It returns nil if u is not propagated.
+func getPropagationStatus(karmadaClient karmadaclientset.Interface, u *unstructured.Unstructured) (*v1.PropagationStatus, error) {
+	bindingName := names.GenerateBindingName(u.GetKind(), u.GetName())
+	if u.GetNamespace() == "" {
+		binding, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(context.TODO(), bindingName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return toPropagationStatus("ClusterResourceBinding", binding.Name, &binding.Spec, &binding.Status), nil
+	}
+
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(u.GetNamespace()).Get(context.TODO(), bindingName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toPropagationStatus("ResourceBinding", binding.Name, &binding.Spec, &binding.Status), nil
+}
+
+// resourcePropagationStatus returns the propagation of u, or nil if it is not propagated or its binding cannot be read.
+func resourcePropagationStatus(u *unstructured.Unstructured) *v1.PropagationStatus {
+	status, err := getPropagationStatus(client.InClusterKarmadaClient(), u)
+	if err != nil {
+		klog.ErrorS(err, "failed to get resource binding", "kind", u.GetKind(), "namespace", u.GetNamespace(), "name", u.GetName())
+		return nil
+	}
+	return status
+}
+
+// listPropagationSummaries returns the propagation summaries of the resources bound in namespace, all namespaces if empty,
+// by bindingKey.
+func listPropagationSummaries(karmadaClient karmadaclientset.Interface, namespace string) (map[string]v1.PropagationSummary, error) {
+	bindings, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	summaries := make(map[string]v1.PropagationSummary, len(bindings.Items))
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		resource := binding.Spec.Resource
+		status := toPropagationStatus("ResourceBinding", binding.Name, &binding.Spec, &binding.Status)
+		summaries[bindingKey(resource.APIVersion, resource.Kind, resource.Namespace, resource.Name)] = status.PropagationSummary
+	}
+	return summaries, nil
+}
+
+// bindingKey identifies the resource of a binding. The version is ignored, as a binding keeps the version of the
+// template when it was created.
+func bindingKey(apiVersion, kind, namespace, name string) string {
+	group := ""
+	if gv, err := schema.ParseGroupVersion(apiVersion); err == nil {
+		group = gv.Group
+	}
+	return group + "/" + kind + "/" + namespace + "/" + name
+}
+
+func toPropagationStatus(bindingKind, bindingName string, spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus) *v1.PropagationStatus {
+	aggregated := make(map[string]workv1alpha2.AggregatedStatusItem, len(status.AggregatedStatus))
+	for _, item := range status.AggregatedStatus {
+		aggregated[item.ClusterName] = item
+	}
+
+	result := &v1.PropagationStatus{
+		BindingKind: bindingKind,
+		BindingName: bindingName,
+		Conditions:  status.Conditions,
+		Clusters:    make([]v1.ClusterPropagation, 0, len(spec.Clusters)),
+	}
+	for _, target := range spec.Clusters {
+		cluster := v1.ClusterPropagation{
+			ClusterName: target.Name,
+			Replicas:    target.Replicas,
+			Health:      string(workv1alpha2.ResourceUnknown),
+		}
+		// the status of a cluster is aggregated once the resource is applied or failed to be applied to it
+		if item, ok := aggregated[target.Name]; ok {
+			cluster.Applied = item.Applied
+			if item.Health != "" {
+				cluster.Health = string(item.Health)
+			}
+			if !item.Applied {
+				cluster.ApplyError = item.AppliedMessage
+			}
+		}
+		if cluster.Health == string(workv1alpha2.ResourceHealthy) {
+			result.HealthyClusters++
+		}
+		result.Clusters = append(result.Clusters, cluster)
+	}
+	result.TotalClusters = len(spec.Clusters)
+	result.FullyApplied = meta.IsStatusConditionTrue(status.Conditions, workv1alpha2.FullyApplied)
+	return result
+}
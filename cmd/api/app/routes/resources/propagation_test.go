@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToPropagationStatus(t *testing.T) {
+	spec := &workv1alpha2.ResourceBindingSpec{
+		Clusters: []workv1alpha2.TargetCluster{
+			{Name: "member1", Replicas: 2},
+			{Name: "member2", Replicas: 1},
+			{Name: "member3"},
+		},
+	}
+	status := &workv1alpha2.ResourceBindingStatus{
+		Conditions: []metav1.Condition{
+			{Type: workv1alpha2.Scheduled, Status: metav1.ConditionTrue},
+			{Type: workv1alpha2.FullyApplied, Status: metav1.ConditionFalse},
+		},
+		AggregatedStatus: []workv1alpha2.AggregatedStatusItem{
+			{ClusterName: "member1", Applied: true, Health: workv1alpha2.ResourceHealthy},
+			{ClusterName: "member2", Applied: false, AppliedMessage: "namespaces \"app\" not found"},
+			// no longer a target cluster
+			{ClusterName: "member4", Applied: true, Health: workv1alpha2.ResourceHealthy},
+		},
+	}
+	expected := &v1.PropagationStatus{
+		PropagationSummary: v1.PropagationSummary{HealthyClusters: 1, TotalClusters: 3, FullyApplied: false},
+		BindingKind:        "ResourceBinding",
+		BindingName:        "web-deployment",
+		Conditions:         status.Conditions,
+		Clusters: []v1.ClusterPropagation{
+			{ClusterName: "member1", Replicas: 2, Applied: true, Health: "Healthy"},
+			{ClusterName: "member2", Replicas: 1, Health: "Unknown", ApplyError: "namespaces \"app\" not found"},
+			{ClusterName: "member3", Health: "Unknown"},
+		},
+	}
+	actual := toPropagationStatus("ResourceBinding", "web-deployment", spec, status)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("toPropagationStatus() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+}
+
+func TestBindingKey(t *testing.T) {
+	cases := []struct {
+		apiVersion string
+		expected   string
+	}{
+		{"apps/v1", "apps/Deployment/default/web"},
+		{"apps/v1beta2", "apps/Deployment/default/web"},
+		{"v1", "/Deployment/default/web"},
+	}
+	for _, c := range cases {
+		actual := bindingKey(c.apiVersion, "Deployment", "default", "web")
+		if actual != c.expected {
+			t.Errorf("bindingKey(%#v) == %#v, expected %#v", c.apiVersion, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"gomodules.xyz/jsonpatch/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// The revisions of a workload template are stored as ControllerRevisions in Karmada, in the namespace of the
+// template and owned by it, as the workload controllers that record revisions do not run in Karmada.
+const (
+	revisionTemplateUIDLabel      = "dashboard.karmada.io/template-uid"
+	revisionChangeCauseAnnotation = "dashboard.karmada.io/change-cause"
+	revisionTriggeredByAnnotation = "dashboard.karmada.io/triggered-by"
+	revisionRollbackToAnnotation  = "dashboard.karmada.io/rollback-to"
+)
+
+// Operations that record a revision.
+const (
+	RevisionCauseCreate   = "create"
+	RevisionCauseUpdate   = "update"
+	RevisionCauseApply    = "apply"
+	RevisionCauseRollback = "rollback"
+	RevisionCauseRestart  = "restart"
+)
+
+// maxTemplateRevisions is the number of revisions kept per template, the oldest are deleted first.
+const maxTemplateRevisions = 10
+
+// operationalSpecFields are the fields of the spec that are not part of a revision: the replicas and the
+// suspension of a CronJob are operated on directly, and a rollback keeps their current value.
+var operationalSpecFields = []string{"replicas", "suspend"}
+
+// revisionKinds are the kinds with a revision history, by kind in lower case.
+var revisionKinds = map[string]bool{
+	"deployment":  true,
+	"statefulset": true,
+	"daemonset":   true,
+	"cronjob":     true,
+}
+
+type revisionStore struct {
+	kubeClient kubeclient.Interface
+}
+
+func newRevisionStore() revisionStore {
+	return revisionStore{kubeClient: client.InClusterClientForKarmadaAPIServer()}
+}
+
+// list returns the revisions of the template, the oldest first.
+func (s revisionStore) list(template *unstructured.Unstructured) ([]appsv1.ControllerRevision, error) {
+	revisions, err := s.kubeClient.AppsV1().ControllerRevisions(template.GetNamespace()).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: revisionTemplateUIDLabel + "=" + string(template.GetUID()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(revisions.Items, func(i, j int) bool {
+		return revisions.Items[i].Revision < revisions.Items[j].Revision
+	})
+	return revisions.Items, nil
+}
+
+// record stores the template as a new revision, unless it is the same as the latest revision which is then returned.
+// The change cause is empty for a template changed outside the dashboard.
+func (s revisionStore) record(template *unstructured.Unstructured, cause, triggeredBy string, rollbackTo int64) (*appsv1.ControllerRevision, error) {
+	revisions, err := s.list(template)
+	if err != nil {
+		return nil, err
+	}
+	data, err := revisionData(template)
+	if err != nil {
+		return nil, err
+	}
+	var next int64 = 1
+	if len(revisions) > 0 {
+		latest := &revisions[len(revisions)-1]
+		if sameRevisionData(latest.Data.Raw, data) {
+			return latest, nil
+		}
+		next = latest.Revision + 1
+	}
+
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: template.GetName() + "-",
+			Namespace:    template.GetNamespace(),
+			Labels:       map[string]string{revisionTemplateUIDLabel: string(template.GetUID())},
+			Annotations:  map[string]string{},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: template.GetAPIVersion(),
+				Kind:       template.GetKind(),
+				Name:       template.GetName(),
+				UID:        template.GetUID(),
+			}},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: next,
+	}
+	if cause != "" {
+		revision.Annotations[revisionChangeCauseAnnotation] = cause
+	}
+	if triggeredBy != "" {
+		revision.Annotations[revisionTriggeredByAnnotation] = triggeredBy
+	}
+	if rollbackTo > 0 {
+		revision.Annotations[revisionRollbackToAnnotation] = strconv.FormatInt(rollbackTo, 10)
+	}
+	created, err := s.kubeClient.AppsV1().ControllerRevisions(template.GetNamespace()).Create(context.TODO(), revision, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.prune(append(revisions, *created))
+	return created, nil
+}
+
+// prune deletes the oldest revisions beyond maxTemplateRevisions.
+func (s revisionStore) prune(revisions []appsv1.ControllerRevision) {
+	for i := 0; i < len(revisions)-maxTemplateRevisions; i++ {
+		err := s.kubeClient.AppsV1().ControllerRevisions(revisions[i].Namespace).Delete(context.TODO(), revisions[i].Name, metav1.DeleteOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Failed to delete template revision", "namespace", revisions[i].Namespace, "name", revisions[i].Name)
+		}
+	}
+}
+
+// rollback restores the spec of the template from a revision and records it as a new revision. The current
+// template is recorded first if it was changed outside the dashboard, so that it can be restored too. A dry run
+// records nothing and returns the restored revision with its diff from the latest revision. The propagation of the
+// result is left to the caller.
+func (s revisionStore) rollback(verber client.ResourceVerber, kind string, template *unstructured.Unstructured, revision int64, triggeredBy string, dryRun bool) (*v1.TemplateRollback, error) {
+	if !dryRun {
+		if _, err := s.record(template, "", "", 0); err != nil {
+			return nil, err
+		}
+	}
+	revisions, err := s.list(template)
+	if err != nil {
+		return nil, err
+	}
+	var target *appsv1.ControllerRevision
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			target = &revisions[i]
+		}
+	}
+	if target == nil {
+		return nil, apperrors.ResourceRevisionNotFound
+	}
+
+	rolledBack, err := rollbackTemplate(template, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := verber.Update(rolledBack); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return &v1.TemplateRollback{Revision: toTemplateRevision(target, &revisions[len(revisions)-1])}, nil
+	}
+	obj, err := verber.Get(kind, template.GetNamespace(), template.GetName())
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := s.record(obj.(*unstructured.Unstructured), RevisionCauseRollback, triggeredBy, revision)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TemplateRollback{Revision: toTemplateRevision(recorded, &revisions[len(revisions)-1])}, nil
+}
+
+// revisionData returns the spec of the template that is recorded in a revision, without the operational fields
+// so that scaling or suspending does not record a revision.
+func revisionData(template *unstructured.Unstructured) ([]byte, error) {
+	spec, _, err := unstructured.NestedMap(template.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range operationalSpecFields {
+		delete(spec, field)
+	}
+	return json.Marshal(map[string]interface{}{"spec": spec})
+}
+
+func sameRevisionData(a, b []byte) bool {
+	patch, err := jsonpatch.CreatePatch(a, b)
+	return err == nil && len(patch) == 0
+}
+
+// rollbackTemplate returns a copy of the template with the spec of the revision and the current operational fields.
+func rollbackTemplate(template *unstructured.Unstructured, revision *appsv1.ControllerRevision) (*unstructured.Unstructured, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal(revision.Data.Raw, &data); err != nil {
+		return nil, err
+	}
+	spec, _, err := unstructured.NestedMap(data, "spec")
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range operationalSpecFields {
+		if value, found, _ := unstructured.NestedFieldCopy(template.Object, "spec", field); found {
+			spec[field] = value
+		}
+	}
+	rolledBack := template.DeepCopy()
+	if err := unstructured.SetNestedMap(rolledBack.Object, spec, "spec"); err != nil {
+		return nil, err
+	}
+	return rolledBack, nil
+}
+
+// toTemplateRevisionList returns the revisions with their diff from the previous revision, and the revision of the
+// current template.
+func toTemplateRevisionList(template *unstructured.Unstructured, revisions []appsv1.ControllerRevision) (*v1.TemplateRevisionList, error) {
+	current, err := revisionData(template)
+	if err != nil {
+		return nil, err
+	}
+	result := &v1.TemplateRevisionList{Revisions: make([]v1.TemplateRevision, 0, len(revisions))}
+	var previous *appsv1.ControllerRevision
+	for i := range revisions {
+		result.Revisions = append(result.Revisions, toTemplateRevision(&revisions[i], previous))
+		if sameRevisionData(revisions[i].Data.Raw, current) {
+			result.CurrentRevision = revisions[i].Revision
+		}
+		previous = &revisions[i]
+	}
+	return result, nil
+}
+
+func toTemplateRevision(revision, previous *appsv1.ControllerRevision) v1.TemplateRevision {
+	result := v1.TemplateRevision{
+		Revision:    revision.Revision,
+		Name:        revision.Name,
+		CreatedAt:   revision.CreationTimestamp,
+		ChangeCause: revision.Annotations[revisionChangeCauseAnnotation],
+		TriggeredBy: revision.Annotations[revisionTriggeredByAnnotation],
+	}
+	result.RollbackTo, _ = strconv.ParseInt(revision.Annotations[revisionRollbackToAnnotation], 10, 64)
+	if previous != nil && previous.Name != revision.Name {
+		diff, err := jsonpatch.CreatePatch(previous.Data.Raw, revision.Data.Raw)
+		if err != nil {
+			klog.ErrorS(err, "Failed to diff template revisions", "namespace", revision.Namespace, "name", revision.Name)
+		}
+		result.Diff = diff
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"gomodules.xyz/jsonpatch/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func deploymentTemplate(image string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "web", "uid": "6d1b7d0e"},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "web", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+// fakeRevisionStore returns a store with a fake client that names the revisions, as the fake client does not
+// support generated names.
+func fakeRevisionStore() revisionStore {
+	kubeClient := kubefake.NewSimpleClientset()
+	created := 0
+	kubeClient.PrependReactor("create", "controllerrevisions", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		revision := action.(k8stesting.CreateAction).GetObject().(*appsv1.ControllerRevision)
+		created++
+		revision.Name = revision.GenerateName + strconv.Itoa(created)
+		return false, nil, nil
+	})
+	return revisionStore{kubeClient: kubeClient}
+}
+
+func TestRevisionStoreRecord(t *testing.T) {
+	store := fakeRevisionStore()
+	record := func(template *unstructured.Unstructured, cause string) int64 {
+		revision, err := store.record(template, cause, "alice", 0)
+		if err != nil {
+			t.Fatalf("record() failed: %v", err)
+		}
+		return revision.Revision
+	}
+
+	if revision := record(deploymentTemplate("nginx:1.25", 2), RevisionCauseCreate); revision != 1 {
+		t.Errorf("record(create) == %d, expected 1", revision)
+	}
+	// scaling does not record a revision
+	if revision := record(deploymentTemplate("nginx:1.25", 5), RevisionCauseUpdate); revision != 1 {
+		t.Errorf("record(scale) == %d, expected 1", revision)
+	}
+	for i := 2; i <= maxTemplateRevisions+2; i++ {
+		if revision := record(deploymentTemplate("nginx:1."+strconv.Itoa(25+i), 2), RevisionCauseUpdate); revision != int64(i) {
+			t.Errorf("record(update) == %d, expected %d", revision, i)
+		}
+	}
+
+	revisions, err := store.list(deploymentTemplate("", 0))
+	if err != nil {
+		t.Fatalf("list() failed: %v", err)
+	}
+	if len(revisions) != maxTemplateRevisions || revisions[0].Revision != 3 {
+		t.Errorf("list() has %d revisions from %d, expected %d from 3", len(revisions), revisions[0].Revision, maxTemplateRevisions)
+	}
+	latest := toTemplateRevision(&revisions[len(revisions)-1], &revisions[len(revisions)-2])
+	expectedDiff := []jsonpatch.Operation{{Operation: "replace", Path: "/spec/template/spec/containers/0/image", Value: "nginx:1.37"}}
+	if latest.ChangeCause != RevisionCauseUpdate || latest.TriggeredBy != "alice" || !reflect.DeepEqual(latest.Diff, expectedDiff) {
+		t.Errorf("toTemplateRevision() == \n%#v\nexpected an update by alice with diff \n%#v\n", latest, expectedDiff)
+	}
+}
+
+func TestRollbackTemplate(t *testing.T) {
+	store := fakeRevisionStore()
+	first, err := store.record(deploymentTemplate("nginx:1.25", 2), RevisionCauseCreate, "", 0)
+	if err != nil {
+		t.Fatalf("record() failed: %v", err)
+	}
+
+	current := deploymentTemplate("nginx:1.26", 4)
+	actual, err := rollbackTemplate(current, first)
+	if err != nil {
+		t.Fatalf("rollbackTemplate() failed: %v", err)
+	}
+	// the replicas are kept
+	expected := deploymentTemplate("nginx:1.25", 4)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("rollbackTemplate() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+
+	revisions, err := store.list(current)
+	if err != nil {
+		t.Fatalf("list() failed: %v", err)
+	}
+	list, err := toTemplateRevisionList(actual, revisions)
+	if err != nil {
+		t.Fatalf("toTemplateRevisionList() failed: %v", err)
+	}
+	if list.CurrentRevision != 1 || len(list.Revisions) != 1 || list.Revisions[0].Diff != nil {
+		t.Errorf("toTemplateRevisionList() == %#v, expected revision 1 as the current revision", list)
+	}
+}
+
+func TestRevisionStoreRollback(t *testing.T) {
+	store := fakeRevisionStore()
+	if _, err := store.record(deploymentTemplate("nginx:1.25", 2), RevisionCauseCreate, "", 0); err != nil {
+		t.Fatalf("record() failed: %v", err)
+	}
+	current := deploymentTemplate("nginx:1.26", 4)
+	verber := &fakeVerber{objects: []*unstructured.Unstructured{current}}
+
+	// the dry run does not record the current template nor the rollback
+	dryRun, err := store.rollback(verber, "deployment", current, 1, "alice", true)
+	if err != nil {
+		t.Fatalf("rollback(dryRun) failed: %v", err)
+	}
+	revisions, err := store.list(current)
+	if err != nil {
+		t.Fatalf("list() failed: %v", err)
+	}
+	if len(revisions) != 1 || dryRun.Revision.Revision != 1 || len(verber.updated) != 1 {
+		t.Errorf("rollback(dryRun) == %#v with %d revisions, expected revision 1 and no revision recorded", dryRun, len(revisions))
+	}
+
+	// the updated template is read back to be recorded
+	verber.objects = []*unstructured.Unstructured{deploymentTemplate("nginx:1.25", 4)}
+	rolledBack, err := store.rollback(verber, "deployment", current, 1, "alice", false)
+	if err != nil {
+		t.Fatalf("rollback() failed: %v", err)
+	}
+	if revisions, _ = store.list(current); len(revisions) != 3 {
+		t.Errorf("list() has %d revisions, expected the current template and the rollback recorded", len(revisions))
+	}
+	if rolledBack.Revision.ChangeCause != RevisionCauseRollback || rolledBack.Revision.RollbackTo != 1 || rolledBack.Revision.TriggeredBy != "alice" {
+		t.Errorf("rollback() == %#v, expected a rollback to revision 1 by alice", rolledBack.Revision)
+	}
+}
@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// restartedAtAnnotation is stamped in the pod template to restart a workload, as kubectl rollout restart does.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// scheduleWaitDuration is how long a scale waits for Karmada to schedule the new replicas to the target clusters.
+const scheduleWaitDuration = 10 * time.Second
+
+// scaleTemplate sets the replicas of the template and returns their split across the target clusters.
+// With Divided scheduling, the split is the one of the Karmada scheduler for the new replicas. A dry run does not
+// wait for the scheduler and returns the current split.
+func scaleTemplate(ctx context.Context, karmadaClient karmadaclientset.Interface, verber resourceUpdater, template *unstructured.Unstructured, replicas int32, dryRun bool) (*v1.ScaleResult, error) {
+	if err := setReplicas(verber, template, replicas); err != nil {
+		return nil, err
+	}
+
+	result := &v1.ScaleResult{Replicas: replicas, Clusters: make([]v1.ClusterReplicas, 0)}
+	bindingName := names.GenerateBindingName(template.GetKind(), template.GetName())
+	var binding *workv1alpha2.ResourceBinding
+	getBinding := func(ctx context.Context) (bool, error) {
+		var err error
+		binding, err = karmadaClient.WorkV1alpha2().ResourceBindings(template.GetNamespace()).Get(ctx, bindingName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			// the template is not propagated
+			binding = nil
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		result.Scheduled = isScheduled(binding, replicas)
+		return result.Scheduled, nil
+	}
+	if dryRun {
+		if _, err := getBinding(ctx); err != nil {
+			return nil, err
+		}
+		result.Scheduled = false
+	} else {
+		err := wait.PollUntilContextTimeout(ctx, 500*time.Millisecond, scheduleWaitDuration, true, getBinding)
+		if err != nil && !wait.Interrupted(err) {
+			return nil, err
+		}
+	}
+	if binding == nil {
+		return result, nil
+	}
+	if !result.Scheduled && !dryRun {
+		klog.InfoS("Replicas not scheduled yet", "namespace", template.GetNamespace(), "name", template.GetName(), "replicas", replicas)
+	}
+
+	if placement := binding.Spec.Placement; placement != nil && placement.ReplicaScheduling != nil {
+		result.SchedulingType = string(placement.ReplicaScheduling.ReplicaSchedulingType)
+	}
+	for _, target := range binding.Spec.Clusters {
+		result.Clusters = append(result.Clusters, v1.ClusterReplicas{ClusterName: target.Name, Replicas: target.Replicas})
+	}
+	return result, nil
+}
+
+// setReplicas sets the replicas of the template, without waiting for Karmada to schedule them.
+func setReplicas(verber resourceUpdater, template *unstructured.Unstructured, replicas int32) error {
+	scaled := template.DeepCopy()
+	if err := unstructured.SetNestedField(scaled.Object, int64(replicas), "spec", "replicas"); err != nil {
+		return err
+	}
+	return verber.Update(scaled)
+}
+
+// isScheduled returns whether the scheduler scheduled the binding with the replicas of the template.
+func isScheduled(binding *workv1alpha2.ResourceBinding, replicas int32) bool {
+	return binding.Spec.Replicas == replicas &&
+		binding.Status.SchedulerObservedGeneration == binding.Generation &&
+		meta.IsStatusConditionTrue(binding.Status.Conditions, workv1alpha2.Scheduled)
+}
+
+// restartTemplate stamps the pod template of the template with the current time. Karmada propagates the
+// change to every target cluster, where the workload controller rolls the pods.
+func restartTemplate(verber resourceUpdater, template *unstructured.Unstructured, now time.Time) (string, error) {
+	restartedAt := now.Format(time.RFC3339)
+	restarted := template.DeepCopy()
+	if err := unstructured.SetNestedField(restarted.Object, restartedAt, "spec", "template", "metadata", "annotations", restartedAtAnnotation); err != nil {
+		return "", err
+	}
+	return restartedAt, verber.Update(restarted)
+}
+
+// resourceUpdater is the part of client.ResourceVerber that updates a resource.
+type resourceUpdater interface {
+	Update(object *unstructured.Unstructured) error
+}
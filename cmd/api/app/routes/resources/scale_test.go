@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fakeUpdater keeps the last updated object.
+type fakeUpdater struct {
+	updated *unstructured.Unstructured
+}
+
+func (u *fakeUpdater) Update(object *unstructured.Unstructured) error {
+	u.updated = object
+	return nil
+}
+
+func TestScaleTemplate(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-deployment", Generation: 3},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Replicas: 5,
+			Placement: &policyv1alpha1.Placement{ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+				ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDivided,
+			}},
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}, {Name: "member2", Replicas: 2}},
+		},
+		Status: workv1alpha2.ResourceBindingStatus{
+			SchedulerObservedGeneration: 3,
+			Conditions:                  []metav1.Condition{{Type: workv1alpha2.Scheduled, Status: metav1.ConditionTrue}},
+		},
+	}
+	updater := &fakeUpdater{}
+	actual, err := scaleTemplate(context.TODO(), karmadafake.NewSimpleClientset(binding), updater, deploymentTemplate("nginx:1.25", 2), 5, false)
+	if err != nil {
+		t.Fatalf("scaleTemplate() failed: %v", err)
+	}
+	expected := &v1.ScaleResult{
+		Replicas:       5,
+		SchedulingType: "Divided",
+		Scheduled:      true,
+		Clusters:       []v1.ClusterReplicas{{ClusterName: "member1", Replicas: 3}, {ClusterName: "member2", Replicas: 2}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("scaleTemplate() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+	if !reflect.DeepEqual(updater.updated, deploymentTemplate("nginx:1.25", 5)) {
+		t.Errorf("scaleTemplate() updated \n%#v\nexpected 5 replicas", updater.updated)
+	}
+
+	// a dry run returns the current split without waiting for the scheduler
+	actual, err = scaleTemplate(context.TODO(), karmadafake.NewSimpleClientset(binding), updater, deploymentTemplate("nginx:1.25", 2), 8, true)
+	if err != nil {
+		t.Fatalf("scaleTemplate(dryRun) failed: %v", err)
+	}
+	expected.Replicas, expected.Scheduled = 8, false
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("scaleTemplate(dryRun) == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+
+	// a template that is not propagated has no split
+	actual, err = scaleTemplate(context.TODO(), karmadafake.NewSimpleClientset(), updater, deploymentTemplate("nginx:1.25", 2), 1, false)
+	if err != nil {
+		t.Fatalf("scaleTemplate() failed: %v", err)
+	}
+	if actual.Scheduled || len(actual.Clusters) != 0 {
+		t.Errorf("scaleTemplate() == %#v, expected no clusters", actual)
+	}
+}
+
+func TestRestartTemplate(t *testing.T) {
+	updater := &fakeUpdater{}
+	now := time.Date(2025, 4, 1, 9, 30, 0, 0, time.UTC)
+	restartedAt, err := restartTemplate(updater, deploymentTemplate("nginx:1.25", 2), now)
+	if err != nil {
+		t.Fatalf("restartTemplate() failed: %v", err)
+	}
+	if restartedAt != "2025-04-01T09:30:00Z" {
+		t.Errorf("restartTemplate() == %#v, expected 2025-04-01T09:30:00Z", restartedAt)
+	}
+	annotations, _, _ := unstructured.NestedStringMap(updater.updated.Object, "spec", "template", "metadata", "annotations")
+	if annotations[restartedAtAnnotation] != restartedAt {
+		t.Errorf("restartTemplate() annotations == %#v, expected %s stamped", annotations, restartedAt)
+	}
+}
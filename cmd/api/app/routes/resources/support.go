@@ -1,15 +1,21 @@
 package resources
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
 	"github.com/karmada-io/dashboard/cmd/api/app/response"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
+	"strconv"
 	"strings"
 )
 
@@ -21,51 +27,172 @@ var supportedKinds = map[string]string{
 	"job":         "Job",
 	"configmap":   "ConfigMap",
 	"secret":      "Secret",
+	// networking, storage, RBAC and autoscaling
+	"service":                 "Service",
+	"ingress":                 "Ingress",
+	"networkpolicy":           "NetworkPolicy",
+	"persistentvolumeclaim":   "PersistentVolumeClaim",
+	"serviceaccount":          "ServiceAccount",
+	"role":                    "Role",
+	"rolebinding":             "RoleBinding",
+	"horizontalpodautoscaler": "HorizontalPodAutoscaler",
 }
 
 const defaultNamespace = "default"
 
+// mutationOptions are the query parameters of the mutating resource routes.
+type mutationOptions struct {
+	// dryRun is set with dryRun=All, the request is validated by the server without persisting anything
+	dryRun bool
+	// serverSideApply applies the manifest instead of creating or replacing the resource
+	serverSideApply bool
+	// force takes over the fields managed by other managers on a server-side apply
+	force bool
+}
+
+func parseMutationOptions(c *gin.Context) (mutationOptions, error) {
+	var options mutationOptions
+	switch c.Query("dryRun") {
+	case "":
+	case metav1.DryRunAll:
+		options.dryRun = true
+	default:
+		return options, apperrors.RequestValueInvalid
+	}
+
+	var err error
+	if options.serverSideApply, err = strconv.ParseBool(c.DefaultQuery("serverSideApply", "false")); err != nil {
+		return options, apperrors.RequestValueInvalid
+	}
+	if options.force, err = strconv.ParseBool(c.DefaultQuery("force", "false")); err != nil {
+		return options, apperrors.RequestValueInvalid
+	}
+	if options.force && !options.serverSideApply {
+		return options, apperrors.RequestValueInvalid
+	}
+	return options, nil
+}
+
+// applyConflicts returns the fields managed by other managers that made a server-side apply fail.
+func applyConflicts(err error) []v1.ApplyConflict {
+	var status apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &status) || status.Status().Details == nil {
+		return nil
+	}
+	var conflicts []v1.ApplyConflict
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		// the message is like: conflict with "kubectl-client-side-apply" using apps/v1
+		manager := ""
+		if _, quoted, ok := strings.Cut(cause.Message, `"`); ok {
+			manager, _, _ = strings.Cut(quoted, `"`)
+		}
+		conflicts = append(conflicts, v1.ApplyConflict{Field: cause.Field, Manager: manager, Message: cause.Message})
+	}
+	return conflicts
+}
+
+// failedWithResourceError responds with the error of a resource operation, with the conflicting fields of a server-side apply.
+func failedWithResourceError(c *gin.Context, err error) {
+	if conflicts := applyConflicts(err); len(conflicts) > 0 {
+		response.FailedWithData(c, apperrors.ResourceApplyConflict, conflicts)
+		return
+	}
+	response.FailedWithError(c, apperrors.ResourceError(err))
+}
+
 // ------------------------ Create / Update ------------------------
 func handleVerberActionWithManifest(
 	c *gin.Context,
+	manifest v1.ManifestRequest,
+	options mutationOptions,
 	isUpdate bool,
-	action func(verber client.ResourceVerber, obj *unstructured.Unstructured) error,
+	action func(verber client.ResourceVerber, obj *unstructured.Unstructured) (*unstructured.Unstructured, error),
 	onSuccess func(*gin.Context),
 ) {
-	verber, err := client.VerberClient(c.Request)
+	verber, err := client.VerberClientWithOptions(c.Request, client.VerberOptions{DryRun: options.dryRun})
 	if err != nil {
 		klog.ErrorS(err, "Failed to init verber client")
 		response.FailedWithError(c, err)
 		return
 	}
 
-	obj, err := parseManifestStrict(c)
+	obj, err := parseManifestStrict(manifest)
 	if err != nil {
 		klog.ErrorS(err, "Failed to parse manifest")
 		response.FailedWithError(c, err)
 		return
 	}
 
-	if err := validatePreconditions(verber, obj, isUpdate); err != nil {
+	kind, err := validateManifestKind(verber, obj.GroupVersionKind())
+	if err != nil {
+		response.FailedWithError(c, err)
+		return
+	}
+
+	if err := validatePreconditions(verber, kind, obj, isUpdate); err != nil {
 		response.FailedWithError(c, err)
 		return
 	}
 
-	if err := action(verber, obj); err != nil {
+	var result *unstructured.Unstructured
+	if options.serverSideApply {
+		result, err = verber.Apply(obj, options.force)
+	} else {
+		result, err = action(verber, obj)
+	}
+	if err != nil {
 		klog.ErrorS(err, "Verber action failed")
-		response.FailedWithError(c, apperrors.ResourceError(err))
+		failedWithResourceError(c, err)
 		return
 	}
 
+	if options.dryRun {
+		// the object as it would be persisted, if the operation returns it
+		response.SuccessWithData(c, msgkey.ResourceDryRunSuccess, result)
+		return
+	}
+	if revisionKinds[kind] {
+		cause := RevisionCauseCreate
+		switch {
+		case options.serverSideApply:
+			cause = RevisionCauseApply
+		case isUpdate:
+			cause = RevisionCauseUpdate
+		}
+		recordRevision(verber, kind, obj, cause, userID(c))
+	}
 	onSuccess(c)
 }
 
-func parseManifestStrict(c *gin.Context) (*unstructured.Unstructured, error) {
+// recordRevision records the template of obj after it was created or updated, a failure does not fail the request.
+func recordRevision(verber client.ResourceVerber, kind string, obj *unstructured.Unstructured, cause, triggeredBy string) {
+	template, err := verber.Get(kind, obj.GetNamespace(), obj.GetName())
+	if err == nil {
+		_, err = newRevisionStore().record(template.(*unstructured.Unstructured), cause, triggeredBy, 0)
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to record template revision", "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+	}
+}
+
+// userID returns the id of the user of the request, empty if the request is not authenticated.
+func userID(c *gin.Context) string {
+	id, _ := common.GetClaims(c)[intra.ClaimUserIdKey].(string)
+	return id
+}
+
+func bindManifest(c *gin.Context) (v1.ManifestRequest, error) {
 	var manifest v1.ManifestRequest
 	if err := c.ShouldBindJSON(&manifest); err != nil {
-		return nil, apperrors.RequestValueInvalid
+		return manifest, apperrors.RequestValueInvalid
 	}
+	return manifest, nil
+}
 
+func parseManifestStrict(manifest v1.ManifestRequest) (*unstructured.Unstructured, error) {
 	jsonBytes, err := yaml.YAMLToJSON([]byte(manifest.Data))
 	if err != nil {
 		return nil, apperrors.InvalidYamlFormat
@@ -76,8 +203,7 @@ func parseManifestStrict(c *gin.Context) (*unstructured.Unstructured, error) {
 		return nil, apperrors.InvalidYamlFormat
 	}
 
-	kind := obj.GetKind()
-	if !IsKindStrictlySupported(kind) {
+	if obj.GetKind() == "" {
 		return nil, apperrors.UnsupportedResourceKind
 	}
 
@@ -95,10 +221,10 @@ func IsKindStrictlySupported(kind string) bool {
 	return ok && kind == expected
 }
 
-func validatePreconditions(verber client.ResourceVerber, obj *unstructured.Unstructured, isUpdate bool) error {
+func validatePreconditions(verber client.ResourceVerber, kind string, obj *unstructured.Unstructured, isUpdate bool) error {
 	if isUpdate {
 		//  check request resource existence for update
-		_, err := verber.Get(strings.ToLower(obj.GetKind()), obj.GetNamespace(), obj.GetName())
+		_, err := verber.Get(kind, obj.GetNamespace(), obj.GetName())
 		if err != nil {
 			klog.ErrorS(err, "Failed to check resource existence")
 			return apperrors.ResourceError(err)
@@ -121,10 +247,11 @@ func validatePreconditions(verber client.ResourceVerber, obj *unstructured.Unstr
 // ------------------------ Get / Delete ------------------------
 func handleVerberActionWithPathParam(
 	c *gin.Context,
+	options mutationOptions,
 	action func(verber client.ResourceVerber, kind, namespace, name string) (interface{}, error),
 	onSuccess func(*gin.Context, interface{}),
 ) {
-	verber, err := client.VerberClient(c.Request)
+	verber, err := client.VerberClientWithOptions(c.Request, client.VerberOptions{DryRun: options.dryRun})
 	if err != nil {
 		klog.ErrorS(err, "Failed to init verber client")
 		response.FailedWithError(c, err)
@@ -132,7 +259,7 @@ func handleVerberActionWithPathParam(
 	}
 
 	kind, namespace, name := c.Param("kind"), c.Param("namespace"), c.Param("name")
-	if err := validateRequestParams(kind, namespace, name); err != nil {
+	if err := validateRequestParams(verber, kind, namespace, name); err != nil {
 		response.FailedWithError(c, err)
 		return
 	}
@@ -140,23 +267,24 @@ func handleVerberActionWithPathParam(
 	obj, err := action(verber, strings.ToLower(kind), namespace, name)
 	if err != nil {
 		klog.ErrorS(err, "Verber action failed", "kind", kind, "namespace", namespace, "name", name)
-		response.FailedWithError(c, apperrors.ResourceError(err))
+		// errors of the dashboard, e.g. a revision not found, are returned as is
+		var httpErr *apperrors.HttpError
+		if !errors.As(err, &httpErr) {
+			err = apperrors.ResourceError(err)
+		}
+		response.FailedWithError(c, err)
 		return
 	}
 
 	onSuccess(c, obj)
 }
 
-func validateRequestParams(kind, namespace, name string) error {
+func validateRequestParams(verber client.ResourceVerber, kind, namespace, name string) error {
 	if kind == "" || namespace == "" || name == "" {
 		return apperrors.RequestValueInvalid
 	}
 
-	if !IsKindSupportedForPathParam(kind) {
-		return apperrors.UnsupportedResourceKind
-	}
-
-	return nil
+	return validatePathKind(verber, kind)
 }
 
 func IsKindSupportedForPathParam(kind string) bool {
@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestApplyConflicts(t *testing.T) {
+	conflict := &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   409,
+		Reason: metav1.StatusReasonConflict,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-client-side-apply" using apps/v1`, Field: ".spec.replicas"},
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "ci" using apps/v1`, Field: `.spec.template.spec.containers[name="web"].image`},
+			},
+		},
+	}}
+	expected := []v1.ApplyConflict{
+		{Field: ".spec.replicas", Manager: "kubectl-client-side-apply", Message: `conflict with "kubectl-client-side-apply" using apps/v1`},
+		{Field: `.spec.template.spec.containers[name="web"].image`, Manager: "ci", Message: `conflict with "ci" using apps/v1`},
+	}
+	if actual := applyConflicts(conflict); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("applyConflicts() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "web")
+	if actual := applyConflicts(notFound); actual != nil {
+		t.Errorf("applyConflicts(NotFound) == %#v, expected nil", actual)
+	}
+}
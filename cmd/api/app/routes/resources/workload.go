@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/client"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// maxWorkloadEvents is the number of warning events in the aggregated status of a workload.
+const maxWorkloadEvents = 10
+
+// replicaFields are the fields of the status of a workload kind with its total, ready, available and updated replicas.
+type replicaFields struct {
+	replicas, ready, available, updated string
+}
+
+// workloadReplicaFields are the workload kinds with an aggregated status. Jobs have their pods counted separately.
+var workloadReplicaFields = map[schema.GroupKind]replicaFields{
+	{Group: "apps", Kind: "Deployment"}:  {"replicas", "readyReplicas", "availableReplicas", "updatedReplicas"},
+	{Group: "apps", Kind: "StatefulSet"}: {"replicas", "readyReplicas", "availableReplicas", "updatedReplicas"},
+	{Group: "apps", Kind: "DaemonSet"}:   {"desiredNumberScheduled", "numberReady", "numberAvailable", "updatedNumberScheduled"},
+	{Group: "batch", Kind: "Job"}:        {ready: "ready"},
+}
+
+func hasAggregatedStatus(u *unstructured.Unstructured) bool {
+	_, ok := workloadReplicaFields[u.GroupVersionKind().GroupKind()]
+	return ok
+}
+
+// resourceAggregatedStatus returns the status of the workload u in the target clusters of its propagation,
+// or nil if u is not a workload or is not propagated.
+func resourceAggregatedStatus(u *unstructured.Unstructured, propagation *v1.PropagationStatus) *v1.AggregatedWorkloadStatus {
+	if propagation == nil || !hasAggregatedStatus(u) {
+		return nil
+	}
+	clusterNames := make([]string, 0, len(propagation.Clusters))
+	for _, cluster := range propagation.Clusters {
+		clusterNames = append(clusterNames, cluster.ClusterName)
+	}
+	aggregator := &workloadStatusAggregator{
+		karmadaClient: client.InClusterKarmadaClient(),
+		memberClient:  client.InClusterClientForMemberCluster,
+	}
+	return aggregator.aggregate(u, clusterNames)
+}
+
+type workloadStatusAggregator struct {
+	karmadaClient karmadaclientset.Interface
+	memberClient  func(clusterName string) kubeclient.Interface
+}
+
+// clusterWorkload is what is read from one target cluster.
+type clusterWorkload struct {
+	status       v1.ClusterWorkloadStatus
+	notReadyPods []v1.NotReadyPod
+	events       []v1.WorkloadEvent
+}
+
+// aggregate reads the target clusters concurrently and merges their status, pods and events.
+func (a *workloadStatusAggregator) aggregate(u *unstructured.Unstructured, clusterNames []string) *v1.AggregatedWorkloadStatus {
+	workloads := make([]clusterWorkload, len(clusterNames))
+	var wg sync.WaitGroup
+	for i, clusterName := range clusterNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workloads[i] = a.read(u, clusterName)
+		}()
+	}
+	wg.Wait()
+
+	result := &v1.AggregatedWorkloadStatus{
+		Clusters:     make([]v1.ClusterWorkloadStatus, 0, len(workloads)),
+		NotReadyPods: make([]v1.NotReadyPod, 0),
+		Events:       make([]v1.WorkloadEvent, 0),
+	}
+	for _, workload := range workloads {
+		replicas := workload.status.WorkloadReplicas
+		result.Replicas += replicas.Replicas
+		result.ReadyReplicas += replicas.ReadyReplicas
+		result.AvailableReplicas += replicas.AvailableReplicas
+		result.UpdatedReplicas += replicas.UpdatedReplicas
+		result.Active += replicas.Active
+		result.Succeeded += replicas.Succeeded
+		result.Failed += replicas.Failed
+		result.Clusters = append(result.Clusters, workload.status)
+		result.NotReadyPods = append(result.NotReadyPods, workload.notReadyPods...)
+		result.Events = append(result.Events, workload.events...)
+	}
+	sort.SliceStable(result.Events, func(i, j int) bool {
+		return result.Events[i].LastSeen.After(result.Events[j].LastSeen.Time)
+	})
+	if len(result.Events) > maxWorkloadEvents {
+		result.Events = result.Events[:maxWorkloadEvents]
+	}
+	return result
+}
+
+// read returns the status of the workload from its Work, and its pods and events from the member cluster.
+// The errors are reported in the status of the cluster, so that the other clusters are still shown.
+func (a *workloadStatusAggregator) read(u *unstructured.Unstructured, clusterName string) clusterWorkload {
+	workload := clusterWorkload{status: v1.ClusterWorkloadStatus{ClusterName: clusterName}}
+	var errs []string
+	if err := a.readWorkStatus(u, &workload.status); err != nil {
+		klog.ErrorS(err, "Failed to get work status", "cluster", clusterName, "kind", u.GetKind(), "namespace", u.GetNamespace(), "name", u.GetName())
+		errs = append(errs, err.Error())
+	}
+
+	memberClient := a.memberClient(clusterName)
+	if memberClient == nil {
+		errs = append(errs, fmt.Sprintf("no client for member cluster %s", clusterName))
+		workload.status.Error = strings.Join(errs, "; ")
+		return workload
+	}
+	pods, err := workloadPods(memberClient, u)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list workload pods", "cluster", clusterName, "namespace", u.GetNamespace(), "name", u.GetName())
+		errs = append(errs, err.Error())
+	}
+	workload.notReadyPods = notReadyPods(clusterName, pods)
+	if workload.events, err = workloadEvents(memberClient, clusterName, u, pods); err != nil {
+		klog.ErrorS(err, "Failed to list workload events", "cluster", clusterName, "namespace", u.GetNamespace(), "name", u.GetName())
+		errs = append(errs, err.Error())
+	}
+	workload.status.Error = strings.Join(errs, "; ")
+	return workload
+}
+
+// readWorkStatus sets the replicas and health of the workload from the manifest status of its Work in the cluster.
+func (a *workloadStatusAggregator) readWorkStatus(u *unstructured.Unstructured, status *v1.ClusterWorkloadStatus) error {
+	workName := names.GenerateWorkName(u.GetKind(), u.GetName(), u.GetNamespace())
+	work, err := a.karmadaClient.WorkV1alpha1().Works(names.GenerateExecutionSpaceName(status.ClusterName)).Get(context.TODO(), workName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	gvk := u.GroupVersionKind()
+	for _, manifest := range work.Status.ManifestStatuses {
+		identifier := manifest.Identifier
+		if identifier.Group != gvk.Group || identifier.Kind != gvk.Kind || identifier.Namespace != u.GetNamespace() || identifier.Name != u.GetName() {
+			continue
+		}
+		status.Health = string(manifest.Health)
+		if manifest.Status == nil {
+			return nil
+		}
+		var memberStatus map[string]interface{}
+		if err := json.Unmarshal(manifest.Status.Raw, &memberStatus); err != nil {
+			return err
+		}
+		status.WorkloadReplicas = toWorkloadReplicas(gvk.GroupKind(), memberStatus)
+		return nil
+	}
+	return nil
+}
+
+// toWorkloadReplicas reads the replicas of a workload kind from the status of a member object.
+func toWorkloadReplicas(groupKind schema.GroupKind, memberStatus map[string]interface{}) v1.WorkloadReplicas {
+	field := func(name string) int32 {
+		if name == "" {
+			return 0
+		}
+		value, _, _ := unstructured.NestedNumberAsFloat64(memberStatus, name)
+		return int32(value)
+	}
+	fields := workloadReplicaFields[groupKind]
+	replicas := v1.WorkloadReplicas{
+		Replicas:          field(fields.replicas),
+		ReadyReplicas:     field(fields.ready),
+		AvailableReplicas: field(fields.available),
+		UpdatedReplicas:   field(fields.updated),
+	}
+	if groupKind.Kind == "Job" {
+		replicas.Active = field("active")
+		replicas.Succeeded = field("succeeded")
+		replicas.Failed = field("failed")
+	}
+	return replicas
+}
+
+// workloadPods lists the pods of the workload in a member cluster, selected by the selector of the template.
+// Jobs usually have their selector generated by the member cluster, their pods have the job-name label.
+func workloadPods(memberClient kubeclient.Interface, u *unstructured.Unstructured) ([]corev1.Pod, error) {
+	selector := ""
+	if rawSelector, found, _ := unstructured.NestedMap(u.Object, "spec", "selector"); found {
+		var labelSelector metav1.LabelSelector
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, &labelSelector); err != nil {
+			return nil, err
+		}
+		parsed, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		if err != nil {
+			return nil, err
+		}
+		selector = parsed.String()
+	} else if u.GetKind() == "Job" {
+		selector = "job-name=" + u.GetName()
+	}
+	if selector == "" {
+		// an empty selector would select all the pods of the namespace
+		return nil, nil
+	}
+
+	pods, err := memberClient.CoreV1().Pods(u.GetNamespace()).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// notReadyPods returns the pods that are not ready. Completed pods, e.g. of Jobs, are not reported.
+func notReadyPods(clusterName string, pods []corev1.Pod) []v1.NotReadyPod {
+	var result []v1.NotReadyPod
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodSucceeded || isPodReady(&pod) {
+			continue
+		}
+		notReady := v1.NotReadyPod{
+			ClusterName: clusterName,
+			Name:        pod.Name,
+			Phase:       string(pod.Status.Phase),
+			Reason:      pod.Status.Reason,
+			Message:     pod.Status.Message,
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			notReady.Restarts += container.RestartCount
+			if notReady.Reason != "" {
+				continue
+			}
+			switch {
+			case container.State.Waiting != nil:
+				notReady.Reason, notReady.Message = container.State.Waiting.Reason, container.State.Waiting.Message
+			case container.State.Terminated != nil:
+				notReady.Reason, notReady.Message = container.State.Terminated.Reason, container.State.Terminated.Message
+			}
+		}
+		result = append(result, notReady)
+	}
+	return result
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// workloadEvents returns the warning events of the workload, of its pods and, for Deployments, of its ReplicaSets.
+func workloadEvents(memberClient kubeclient.Interface, clusterName string, u *unstructured.Unstructured, pods []corev1.Pod) ([]v1.WorkloadEvent, error) {
+	events, err := memberClient.CoreV1().Events(u.GetNamespace()).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	podNames := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		podNames[pod.Name] = true
+	}
+	var replicaSetNames map[string]bool
+	if u.GetKind() == "Deployment" {
+		if replicaSetNames, err = ownedReplicaSets(memberClient, u); err != nil {
+			return nil, err
+		}
+	}
+	isWorkloadObject := func(object corev1.ObjectReference) bool {
+		switch object.Kind {
+		case u.GetKind():
+			return object.Name == u.GetName()
+		case "Pod":
+			return podNames[object.Name]
+		case "ReplicaSet":
+			return replicaSetNames[object.Name]
+		}
+		return false
+	}
+
+	var result []v1.WorkloadEvent
+	for _, event := range events.Items {
+		if !isWorkloadObject(event.InvolvedObject) {
+			continue
+		}
+		result = append(result, v1.WorkloadEvent{
+			ClusterName: clusterName,
+			ObjectKind:  event.InvolvedObject.Kind,
+			ObjectName:  event.InvolvedObject.Name,
+			Reason:      event.Reason,
+			Message:     event.Message,
+			Count:       event.Count,
+			LastSeen:    eventLastSeen(&event),
+		})
+	}
+	return result, nil
+}
+
+// ownedReplicaSets returns the names of the ReplicaSets owned by the Deployment in a member cluster. They are
+// matched by owner, as the name prefix of a ReplicaSet is shared by Deployments with names like web and web-api.
+func ownedReplicaSets(memberClient kubeclient.Interface, u *unstructured.Unstructured) (map[string]bool, error) {
+	replicaSets, err := memberClient.AppsV1().ReplicaSets(u.GetNamespace()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, replicaSet := range replicaSets.Items {
+		for _, owner := range replicaSet.OwnerReferences {
+			if owner.Kind == "Deployment" && owner.Name == u.GetName() {
+				names[replicaSet.Name] = true
+			}
+		}
+	}
+	return names, nil
+}
+
+// eventLastSeen returns the last time the event was seen, events.k8s.io events only have an event time.
+func eventLastSeen(event *corev1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.CreationTimestamp
+}
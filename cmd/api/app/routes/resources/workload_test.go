@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestToWorkloadReplicas(t *testing.T) {
+	cases := []struct {
+		groupKind schema.GroupKind
+		status    map[string]interface{}
+		expected  v1.WorkloadReplicas
+	}{
+		{
+			schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			map[string]interface{}{"replicas": int64(3), "readyReplicas": int64(2), "availableReplicas": int64(2), "updatedReplicas": int64(3)},
+			v1.WorkloadReplicas{Replicas: 3, ReadyReplicas: 2, AvailableReplicas: 2, UpdatedReplicas: 3},
+		},
+		{
+			schema.GroupKind{Group: "apps", Kind: "DaemonSet"},
+			map[string]interface{}{"desiredNumberScheduled": int64(4), "numberReady": int64(4), "numberAvailable": int64(3), "updatedNumberScheduled": int64(1)},
+			v1.WorkloadReplicas{Replicas: 4, ReadyReplicas: 4, AvailableReplicas: 3, UpdatedReplicas: 1},
+		},
+		{
+			schema.GroupKind{Group: "batch", Kind: "Job"},
+			map[string]interface{}{"ready": int64(1), "active": int64(2), "succeeded": int64(3), "failed": int64(1)},
+			v1.WorkloadReplicas{ReadyReplicas: 1, Active: 2, Succeeded: 3, Failed: 1},
+		},
+	}
+	for _, c := range cases {
+		actual := toWorkloadReplicas(c.groupKind, c.status)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("toWorkloadReplicas(%#v) == \n%#v\nexpected \n%#v\n", c.groupKind, actual, c.expected)
+		}
+	}
+}
+
+func TestNotReadyPods(t *testing.T) {
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1"},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-2"},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+				ContainerStatuses: []corev1.ContainerStatus{{
+					RestartCount: 5,
+					State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off"}},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-3"},
+			Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+	}
+	expected := []v1.NotReadyPod{
+		{ClusterName: "member1", Name: "web-2", Phase: "Running", Reason: "CrashLoopBackOff", Message: "back-off", Restarts: 5},
+	}
+	if actual := notReadyPods("member1", pods); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("notReadyPods() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+}
+
+func TestAggregateWorkloadStatus(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "web"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+		},
+	}}
+	work := func(clusterName string, replicas, ready int) *workv1alpha1.Work {
+		return &workv1alpha1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: names.GenerateExecutionSpaceName(clusterName),
+				Name:      names.GenerateWorkName("Deployment", "web", "default"),
+			},
+			Status: workv1alpha1.WorkStatus{ManifestStatuses: []workv1alpha1.ManifestStatus{{
+				Identifier: workv1alpha1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "web"},
+				Status:     &runtime.RawExtension{Raw: []byte(`{"replicas":` + strconv.Itoa(replicas) + `,"readyReplicas":` + strconv.Itoa(ready) + `}`)},
+				Health:     workv1alpha1.ResourceHealthy,
+			}}},
+		}
+	}
+	now := time.Now()
+	members := map[string]kubeclient.Interface{
+		"member1": kubefake.NewSimpleClientset(),
+		"member2": kubefake.NewSimpleClientset(
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-abc-1", Labels: map[string]string{"app": "web"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodPending, Reason: "Unschedulable"},
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: "web-abc-1.1"},
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-abc-1"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "FailedScheduling",
+				Count:          3,
+				LastTimestamp:  metav1.NewTime(now),
+			},
+			&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default", Name: "web-7d9f4c", OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web"}},
+			}},
+			// the ReplicaSet of another deployment with the same name prefix
+			&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default", Name: "web-api-7d9f4c", OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web-api"}},
+			}},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: "web-7d9f4c.1"},
+				InvolvedObject: corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-7d9f4c"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "FailedCreate",
+				LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: "web-api-7d9f4c.1"},
+				InvolvedObject: corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-api-7d9f4c"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "FailedCreate",
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: "api.1"},
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "api-1"},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "BackOff",
+			},
+		),
+	}
+	aggregator := &workloadStatusAggregator{
+		karmadaClient: karmadafake.NewSimpleClientset(work("member1", 2, 2), work("member2", 1, 0)),
+		memberClient: func(clusterName string) kubeclient.Interface {
+			return members[clusterName]
+		},
+	}
+
+	actual := aggregator.aggregate(deployment, []string{"member1", "member2", "member3"})
+	if actual.Replicas != 3 || actual.ReadyReplicas != 2 {
+		t.Errorf("aggregate() replicas == %d/%d, expected 2/3 ready", actual.ReadyReplicas, actual.Replicas)
+	}
+	if len(actual.Clusters) != 3 || actual.Clusters[0].Health != "Healthy" || actual.Clusters[0].Error != "" || actual.Clusters[2].Error == "" {
+		t.Errorf("aggregate() clusters == %#v, expected member3 with an error", actual.Clusters)
+	}
+	expectedPods := []v1.NotReadyPod{{ClusterName: "member2", Name: "web-abc-1", Phase: "Pending", Reason: "Unschedulable"}}
+	if !reflect.DeepEqual(actual.NotReadyPods, expectedPods) {
+		t.Errorf("aggregate() notReadyPods == \n%#v\nexpected \n%#v\n", actual.NotReadyPods, expectedPods)
+	}
+	eventObjects := make([]string, 0, len(actual.Events))
+	for _, event := range actual.Events {
+		eventObjects = append(eventObjects, event.ClusterName+"/"+event.ObjectName+"="+event.Reason)
+	}
+	expectedEvents := []string{"member2/web-abc-1=FailedScheduling", "member2/web-7d9f4c=FailedCreate"}
+	if !reflect.DeepEqual(eventObjects, expectedEvents) {
+		t.Errorf("aggregate() events == %v, expected %v", eventObjects, expectedEvents)
+	}
+}
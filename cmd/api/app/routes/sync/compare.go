@@ -0,0 +1,33 @@
+package sync
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Comparisons of a sync candidate with the Karmada object of the same name.
+const (
+	// ComparisonAbsent means there is no Karmada object with the same name
+	ComparisonAbsent = "absent"
+	// ComparisonIdentical means both objects are the same after sanitizing
+	ComparisonIdentical = "identical"
+	// ComparisonDiffers means both objects still differ after sanitizing
+	ComparisonDiffers = "differs"
+)
+
+// compareObjects compares the member and karmada objects the way a sync would see them,
+// with the fields set by each cluster removed from both sides.
+// It returns the comparison and the paths of the fields that differ.
+func compareObjects(member, karmada *unstructured.Unstructured) (string, []string) {
+	if karmada == nil {
+		return ComparisonAbsent, nil
+	}
+	diffs := diffObjects(sanitizeObject(karmada).Object, sanitizeObject(member).Object)
+	if len(diffs) == 0 {
+		return ComparisonIdentical, nil
+	}
+	paths := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		paths = append(paths, diff.Path)
+	}
+	return ComparisonDiffers, paths
+}
@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCompareObjects(t *testing.T) {
+	configMap := func(metadata map[string]interface{}, data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   metadata,
+			"data":       data,
+		}}
+	}
+	member := configMap(memberMetadata("config", nil, nil), map[string]interface{}{"key": "value"})
+
+	cases := []struct {
+		name               string
+		karmada            *unstructured.Unstructured
+		expectedComparison string
+		expectedPaths      []string
+	}{
+		{"absent", nil, ComparisonAbsent, nil},
+		{
+			"identical",
+			configMap(map[string]interface{}{
+				"name":              "config",
+				"namespace":         "default",
+				"resourceVersion":   "1",
+				"uid":               "9f8e7d6c",
+				"creationTimestamp": "2025-02-01T00:00:00Z",
+				"finalizers":        []interface{}{"example.com/cleanup", "resourcebinding.karmada.io/dependencies-distributor"},
+				"labels":            map[string]interface{}{"app": "config"},
+				"annotations": map[string]interface{}{
+					"team":                            "payments",
+					"resourcetemplate.karmada.io/uid": "9f8e7d6c",
+				},
+			}, map[string]interface{}{"key": "value"}),
+			ComparisonIdentical,
+			nil,
+		},
+		{
+			"differs",
+			configMap(sanitizedMetadata("config"), map[string]interface{}{"key": "other", "extra": "value"}),
+			ComparisonDiffers,
+			[]string{"data.extra", "data.key"},
+		},
+	}
+	for _, c := range cases {
+		comparison, paths := compareObjects(member, c.karmada)
+		if comparison != c.expectedComparison || !reflect.DeepEqual(paths, c.expectedPaths) {
+			t.Errorf("compareObjects(%s) == %#v, %#v\nexpected %#v, %#v\n", c.name, comparison, paths, c.expectedComparison, c.expectedPaths)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+)
+
+// Kinds of the objects a pod template can depend on.
+const (
+	dependencyConfigMap             = "configmap"
+	dependencySecret                = "secret"
+	dependencyServiceAccount        = "serviceaccount"
+	dependencyPersistentVolumeClaim = "persistentvolumeclaim"
+)
+
+// defaultServiceAccount exists in every namespace and is never synced as a dependency.
+const defaultServiceAccount = "default"
+
+// podSpecPaths are the paths of the pod spec in the workloads whose dependencies are synced.
+var podSpecPaths = map[schema.GroupResource][]string{
+	{Group: "apps", Resource: "deployments"}:  {"spec", "template", "spec"},
+	{Group: "apps", Resource: "statefulsets"}: {"spec", "template", "spec"},
+	{Group: "apps", Resource: "daemonsets"}:   {"spec", "template", "spec"},
+	{Group: "batch", Resource: "jobs"}:        {"spec", "template", "spec"},
+	{Group: "batch", Resource: "cronjobs"}:    {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// SyncDependency is an object added to a sync because a selected workload references it.
+type SyncDependency struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	// RequiredBy lists the workloads referencing the object as <kind>/<name>
+	RequiredBy []string `json:"requiredBy"`
+}
+
+// dependencyResolver adds the objects referenced by the pod templates of the requested workloads to a sync request.
+type dependencyResolver struct {
+	ctx    context.Context
+	member dynamic.Interface
+	kinds  *SyncResourceKinds
+}
+
+// resolve returns a copy of request with the dependencies of its workloads added in front of each namespace,
+// so that they are created before the workloads, and the list of added dependencies.
+func (d *dependencyResolver) resolve(request SyncRequest) (SyncRequest, []SyncDependency) {
+	dependencies := make([]SyncDependency, 0)
+	data := make([]SyncRequestData, 0, len(request.Data))
+
+	for _, nsRes := range request.Data {
+		requested := make(map[string]sets.Set[string])
+		for _, kindGroup := range nsRes.List {
+			kind := kindGroup.Kind
+			if res, ok := d.kinds.Lookup(kind); ok {
+				kind = res.Kind
+			}
+			if requested[kind] == nil {
+				requested[kind] = sets.New[string]()
+			}
+			requested[kind].Insert(kindGroup.List...)
+		}
+
+		found := make(map[string]*SyncDependency)
+		// required holds the dependencies referenced at least once without optional: true
+		required := sets.New[string]()
+		var order []string
+		for _, kindGroup := range nsRes.List {
+			res, ok := d.kinds.Lookup(kindGroup.Kind)
+			if !ok || nsRes.Namespace == "" {
+				continue
+			}
+			path, ok := podSpecPaths[res.GVR().GroupResource()]
+			if !ok {
+				continue
+			}
+			for _, name := range kindGroup.List {
+				refs, err := d.podTemplateReferences(res, nsRes.Namespace, name, path)
+				if err != nil {
+					// the workload itself fails with the same error during sync
+					log.Printf("%v dependency lookup fail(%v - %v) : %v", res.Kind, nsRes.Namespace, name, err)
+					continue
+				}
+				for _, ref := range refs {
+					if requested[ref.Kind].Has(ref.Name) {
+						continue
+					}
+					key := ref.Kind + "/" + ref.Name
+					dep, ok := found[key]
+					if !ok {
+						dep = &SyncDependency{Namespace: nsRes.Namespace, Kind: ref.Kind, Name: ref.Name, RequiredBy: []string{}}
+						found[key] = dep
+						order = append(order, key)
+					}
+					dep.RequiredBy = append(dep.RequiredBy, res.Kind+"/"+name)
+					if !ref.Optional {
+						required.Insert(key)
+					}
+				}
+			}
+		}
+
+		list := make([]SyncRequestResource, 0, len(nsRes.List)+len(order))
+		byKind := make(map[string]int)
+		for _, key := range order {
+			dep := found[key]
+			res, ok := d.kinds.Lookup(dep.Kind)
+			if !ok {
+				log.Printf("dependency %v is not syncable(%v)", key, nsRes.Namespace)
+				continue
+			}
+			// pods start without an optional object, so a missing one is not a sync failure
+			if !required.Has(key) && !d.exists(res, nsRes.Namespace, dep.Name) {
+				log.Printf("optional dependency %v does not exist(%v)", key, nsRes.Namespace)
+				continue
+			}
+			i, ok := byKind[dep.Kind]
+			if !ok {
+				i = len(list)
+				byKind[dep.Kind] = i
+				list = append(list, SyncRequestResource{Kind: dep.Kind})
+			}
+			list[i].List = append(list[i].List, dep.Name)
+			dependencies = append(dependencies, *dep)
+		}
+		list = append(list, nsRes.List...)
+		data = append(data, SyncRequestData{Namespace: nsRes.Namespace, List: list})
+	}
+
+	request.Data = data
+	return request, dependencies
+}
+
+// exists reports whether the object exists in the member cluster. Errors other than NotFound are reported when
+// the object is synced.
+func (d *dependencyResolver) exists(res SyncResourceKind, namespace, name string) bool {
+	_, err := resourceClient(d.member, res, namespace).Get(d.ctx, name, metav1.GetOptions{})
+	return !errors.IsNotFound(err)
+}
+
+type podTemplateReference struct {
+	Kind string
+	Name string
+	// Optional is set if the pod template marks the reference as optional
+	Optional bool
+}
+
+// podTemplateReferences reads the workload from the member cluster and returns the objects its pod template references.
+func (d *dependencyResolver) podTemplateReferences(res SyncResourceKind, namespace, name string, path []string) ([]podTemplateReference, error) {
+	obj, err := resourceClient(d.member, res, namespace).Get(d.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	rawSpec, found, err := unstructured.NestedMap(obj.Object, path...)
+	if err != nil || !found {
+		return nil, fmt.Errorf("pod template is not found: %v", err)
+	}
+	var spec corev1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, &spec); err != nil {
+		return nil, err
+	}
+	return podSpecReferences(&spec), nil
+}
+
+// podSpecReferences returns the config maps, secrets, service account and persistent volume claims used by spec,
+// marked optional if every use of them is optional.
+func podSpecReferences(spec *corev1.PodSpec) []podTemplateReference {
+	var refs []podTemplateReference
+	// seen holds the index of each reference, a reference is optional only if every use of it is optional
+	seen := make(map[string]int)
+	add := func(kind, name string, optional *bool) {
+		if name == "" {
+			return
+		}
+		isOptional := optional != nil && *optional
+		if i, ok := seen[kind+"/"+name]; ok {
+			refs[i].Optional = refs[i].Optional && isOptional
+			return
+		}
+		seen[kind+"/"+name] = len(refs)
+		refs = append(refs, podTemplateReference{Kind: kind, Name: name, Optional: isOptional})
+	}
+
+	if spec.ServiceAccountName != "" && spec.ServiceAccountName != defaultServiceAccount {
+		add(dependencyServiceAccount, spec.ServiceAccountName, nil)
+	}
+	for _, secret := range spec.ImagePullSecrets {
+		add(dependencySecret, secret.Name, nil)
+	}
+
+	for _, volume := range spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			add(dependencyConfigMap, volume.ConfigMap.Name, volume.ConfigMap.Optional)
+		case volume.Secret != nil:
+			add(dependencySecret, volume.Secret.SecretName, volume.Secret.Optional)
+		case volume.PersistentVolumeClaim != nil:
+			add(dependencyPersistentVolumeClaim, volume.PersistentVolumeClaim.ClaimName, nil)
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add(dependencyConfigMap, source.ConfigMap.Name, source.ConfigMap.Optional)
+				}
+				if source.Secret != nil {
+					add(dependencySecret, source.Secret.Name, source.Secret.Optional)
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(dependencyConfigMap, envFrom.ConfigMapRef.Name, envFrom.ConfigMapRef.Optional)
+			}
+			if envFrom.SecretRef != nil {
+				add(dependencySecret, envFrom.SecretRef.Name, envFrom.SecretRef.Optional)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add(dependencyConfigMap, env.ValueFrom.ConfigMapKeyRef.Name, env.ValueFrom.ConfigMapKeyRef.Optional)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add(dependencySecret, env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Optional)
+			}
+		}
+	}
+	return refs
+}
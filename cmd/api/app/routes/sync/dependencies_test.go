@@ -0,0 +1,156 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestPodSpecReferences(t *testing.T) {
+	optional := true
+	spec := &corev1.PodSpec{
+		ServiceAccountName: "web",
+		ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "registry"}},
+		Volumes: []corev1.Volume{
+			{VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-config"}}}},
+			{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "web-tls", Optional: &optional}}},
+			{VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "web-data"}}},
+			{VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+				{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "web-ca"}, Optional: &optional}},
+				{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "web-token"}}},
+			}}}},
+		},
+		InitContainers: []corev1.Container{{
+			EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-env"}, Optional: &optional}}},
+		}},
+		Containers: []corev1.Container{{
+			EnvFrom: []corev1.EnvFromSource{
+				{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-secrets"}}},
+				// the required use makes the reference required
+				{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-env"}}},
+			},
+			Env: []corev1.EnvVar{
+				{Name: "PLAIN", Value: "1"},
+				{Name: "MODE", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "web-flags"}, Key: "mode", Optional: &optional}}},
+				{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "web-secrets"}, Key: "password"}}},
+				{Name: "NODE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+			},
+		}},
+	}
+	expected := []podTemplateReference{
+		{Kind: dependencyServiceAccount, Name: "web"},
+		{Kind: dependencySecret, Name: "registry"},
+		{Kind: dependencyConfigMap, Name: "web-config"},
+		{Kind: dependencySecret, Name: "web-tls", Optional: true},
+		{Kind: dependencyPersistentVolumeClaim, Name: "web-data"},
+		{Kind: dependencyConfigMap, Name: "web-ca", Optional: true},
+		{Kind: dependencySecret, Name: "web-token"},
+		{Kind: dependencyConfigMap, Name: "web-env"},
+		{Kind: dependencySecret, Name: "web-secrets"},
+		{Kind: dependencyConfigMap, Name: "web-flags", Optional: true},
+	}
+	if actual := podSpecReferences(spec); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("podSpecReferences() == \n%#v\nexpected \n%#v", actual, expected)
+	}
+
+	// the default service account exists in every namespace
+	if actual := podSpecReferences(&corev1.PodSpec{ServiceAccountName: defaultServiceAccount}); len(actual) != 0 {
+		t.Errorf("podSpecReferences(default service account) == %#v, expected none", actual)
+	}
+}
+
+func TestDependencyResolverResolve(t *testing.T) {
+	deploymentKind := SyncResourceKind{Kind: "deployment", Group: "apps", Version: "v1", Resource: "deployments", Namespaced: true}
+	optional := true
+	spec := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-config"}}}},
+			{Name: "missing", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-missing"}}}},
+			{Name: "extra", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-extra"}, Optional: &optional}}},
+			{Name: "absent", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-absent"}, Optional: &optional}}},
+		},
+	}
+	rawSpec, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "web"},
+		"spec":       map[string]interface{}{"template": map[string]interface{}{"spec": rawSpec}},
+	}}
+	resolver := &dependencyResolver{
+		ctx: t.Context(),
+		member: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			deployment,
+			configMap("web-config", nil),
+			configMap("web-extra", nil),
+		),
+		kinds: &SyncResourceKinds{kinds: map[string]SyncResourceKind{"deployment": deploymentKind, "configmap": configMapKind}},
+	}
+
+	request, dependencies := resolver.resolve(SyncRequest{Data: []SyncRequestData{
+		{Namespace: "default", List: []SyncRequestResource{{Kind: "deployment", List: []string{"web"}}}},
+	}})
+	// a required dependency that does not exist fails in the sync, an optional one is left out
+	expected := []SyncRequestResource{
+		{Kind: "configmap", List: []string{"web-config", "web-missing", "web-extra"}},
+		{Kind: "deployment", List: []string{"web"}},
+	}
+	if !reflect.DeepEqual(request.Data[0].List, expected) {
+		t.Errorf("resolve() request == %#v, expected %#v", request.Data[0].List, expected)
+	}
+	if len(dependencies) != 3 || !reflect.DeepEqual(dependencies[0].RequiredBy, []string{"deployment/web"}) {
+		t.Errorf("resolve() dependencies == %#v, expected 3 dependencies required by deployment/web", dependencies)
+	}
+}
+
+func TestDependenciesAreNotFiltered(t *testing.T) {
+	scheme := runtime.NewScheme()
+	labeled := configMap("web", nil)
+	labeled.SetLabels(map[string]string{"sync": "true"})
+	member := dynamicfake.NewSimpleDynamicClient(scheme, labeled, configMap("web-config", nil), configMap("other", nil))
+	kinds := &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind}}
+	request := SyncRequest{Data: []SyncRequestData{{Namespace: "default", List: []SyncRequestResource{
+		{Kind: "configmap", List: []string{"web-config"}},
+		{Kind: "configmap", List: []string{"web", "other"}},
+	}}}}
+	filter, _ := newSyncFilter("sync=true", false, false)
+	filter.exemptDependencies([]SyncDependency{{Namespace: "default", Kind: "configmap", Name: "web-config", RequiredBy: []string{"deployment/web"}}})
+
+	previewer := &syncPreviewer{
+		ctx:      t.Context(),
+		member:   member,
+		karmada:  dynamicfake.NewSimpleDynamicClient(scheme, namespace("default")),
+		kinds:    kinds,
+		filter:   filter,
+		localize: func(key string) string { return key },
+	}
+	actions := make([]string, 0)
+	for _, item := range previewer.preview(request).Items {
+		actions = append(actions, item.Name+"="+item.Action)
+	}
+	expected := []string{"web-config=create", "web=create", "other=skipped"}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("preview() == %v, expected %v", actions, expected)
+	}
+
+	runner := &syncRunner{
+		ctx:     t.Context(),
+		member:  member,
+		karmada: dynamicfake.NewSimpleDynamicClient(scheme, namespace("default")),
+		kinds:   kinds,
+		filter:  filter,
+		request: request,
+	}
+	outcomes := make([]string, 0)
+	for _, item := range runner.run().Items {
+		outcomes = append(outcomes, item.Name+"="+item.Outcome)
+	}
+	expected = []string{"web-config=created", "web=created", "other=skipped"}
+	if !reflect.DeepEqual(outcomes, expected) {
+		t.Errorf("run() == %v, expected %v", outcomes, expected)
+	}
+}
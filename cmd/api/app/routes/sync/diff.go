@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldDiff is a field that differs between the Karmada object and the object read from the member cluster.
+// A missing side is omitted.
+type FieldDiff struct {
+	Path    string      `json:"path"`
+	Karmada interface{} `json:"karmada,omitempty"`
+	Member  interface{} `json:"member,omitempty"`
+	// pointer is the JSON pointer (RFC 6901) of the field
+	pointer string
+}
+
+// diffObjects returns the fields that differ between the karmada and member objects, sorted by path.
+func diffObjects(karmada, member map[string]interface{}) []FieldDiff {
+	diffs := make([]FieldDiff, 0)
+	diffValues("", "", karmada, member, &diffs)
+	return diffs
+}
+
+func diffValues(path, pointer string, karmada, member interface{}, diffs *[]FieldDiff) {
+	switch k := karmada.(type) {
+	case map[string]interface{}:
+		if m, ok := member.(map[string]interface{}); ok {
+			diffMaps(path, pointer, k, m, diffs)
+			return
+		}
+	case []interface{}:
+		// lists of the same length are compared item by item, otherwise the whole list differs
+		if m, ok := member.([]interface{}); ok && len(k) == len(m) {
+			for i := range k {
+				diffValues(fmt.Sprintf("%s[%d]", path, i), fmt.Sprintf("%s/%d", pointer, i), k[i], m[i], diffs)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(karmada, member) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Karmada: karmada, Member: member, pointer: pointer})
+	}
+}
+
+func diffMaps(path, pointer string, karmada, member map[string]interface{}, diffs *[]FieldDiff) {
+	keys := make([]string, 0, len(karmada)+len(member))
+	for key := range karmada {
+		keys = append(keys, key)
+	}
+	for key := range member {
+		if _, ok := karmada[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diffValues(fieldPath(path, key), pointer+"/"+pointerEscaper.Replace(key), karmada[key], member[key], diffs)
+	}
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// fieldPath appends key to path, quoting keys such as label names that contain dots or slashes.
+func fieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
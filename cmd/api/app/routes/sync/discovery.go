@@ -0,0 +1,250 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/klog/v2"
+)
+
+// namespaceGVR is used to check and create the namespaces of synced resources.
+var namespaceGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}
+
+// requiredVerbs are the verbs a resource must support in both clusters to be synced.
+var requiredVerbs = []string{"get", "list", "create"}
+
+// SyncResourceKind is a resource that is served by both the member cluster and Karmada.
+type SyncResourceKind struct {
+	// Kind is the name used for the resource in sync requests,
+	// the lower-case kind (e.g. deployment) or <resource>.<group> if the kind is ambiguous.
+	Kind       string `json:"kind"`
+	Group      string `json:"group"`
+	Version    string `json:"version"`
+	Resource   string `json:"resource"`
+	Namespaced bool   `json:"namespaced"`
+}
+
+func (k SyncResourceKind) GVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: k.Group, Version: k.Version, Resource: k.Resource}
+}
+
+// SyncResourceKinds holds the syncable resources by the names they can be requested with.
+type SyncResourceKinds struct {
+	kinds map[string]SyncResourceKind
+	names []string
+}
+
+// Lookup returns the resource for a kind name (e.g. deployment) or a <resource>.<group> name (e.g. deployments.apps).
+func (k *SyncResourceKinds) Lookup(kind string) (SyncResourceKind, bool) {
+	res, ok := k.kinds[strings.ToLower(kind)]
+	return res, ok
+}
+
+// List returns the syncable resources sorted by their kind name.
+func (k *SyncResourceKinds) List() []SyncResourceKind {
+	result := make([]SyncResourceKind, 0, len(k.names))
+	for _, name := range k.names {
+		result = append(result, k.kinds[name])
+	}
+	return result
+}
+
+// DiscoverSyncResourceKinds returns the resources that can be listed in the member cluster and created in Karmada
+// with the same group and version, filtered by the SyncAllowedResources and SyncDeniedResources settings.
+func DiscoverSyncResourceKinds(member, karmada discovery.DiscoveryInterface) (*SyncResourceKinds, error) {
+	memberResources, err := servedResources(member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover member cluster resources: %w", err)
+	}
+	karmadaResources, err := servedResources(karmada)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover karmada resources: %w", err)
+	}
+	filter := newResourceFilter(intra.Env.SyncAllowedResources, intra.Env.SyncDeniedResources)
+
+	kinds := &SyncResourceKinds{kinds: make(map[string]SyncResourceKind)}
+	for _, list := range karmadaResources.preferred {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, apiResource := range list.APIResources {
+			gvr := gv.WithResource(apiResource.Name)
+			if !isSyncable(apiResource) || !filter.allows(gvr.GroupResource()) {
+				continue
+			}
+			if !memberResources.all.Has(gvr) {
+				klog.V(2).InfoS("Resource is not served by the member cluster with the same version", "gvr", gvr.String())
+				continue
+			}
+			kinds.add(SyncResourceKind{
+				Kind:       strings.ToLower(apiResource.Kind),
+				Group:      gvr.Group,
+				Version:    gvr.Version,
+				Resource:   gvr.Resource,
+				Namespaced: apiResource.Namespaced,
+			})
+		}
+	}
+	sort.Strings(kinds.names)
+	return kinds, nil
+}
+
+// add registers res under <resource>.<group> and, unless another group already claimed it, under its kind.
+func (k *SyncResourceKinds) add(res SyncResourceKind) {
+	qualified := res.GVR().GroupResource().String()
+	if _, exists := k.kinds[res.Kind]; exists {
+		res.Kind = qualified
+	} else {
+		k.kinds[qualified] = res
+	}
+	k.kinds[res.Kind] = res
+	k.names = append(k.names, res.Kind)
+}
+
+type discoveredResources struct {
+	// preferred holds the preferred version of each resource, in the priority order of the API groups
+	preferred []*metav1.APIResourceList
+	// all holds every served version of each resource
+	all sets.Set[schema.GroupVersionResource]
+}
+
+func servedResources(client discovery.DiscoveryInterface) (*discoveredResources, error) {
+	preferred, err := client.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	if err != nil {
+		// some aggregated APIs may be unavailable, the remaining groups are still usable
+		klog.Warningf("Partial API discovery result: %v", err)
+	}
+	_, all, err := client.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	result := &discoveredResources{preferred: preferred, all: sets.New[schema.GroupVersionResource]()}
+	for _, list := range all {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, apiResource := range list.APIResources {
+			if isSyncable(apiResource) {
+				result.all.Insert(gv.WithResource(apiResource.Name))
+			}
+		}
+	}
+	return result, nil
+}
+
+func isSyncable(apiResource metav1.APIResource) bool {
+	// sub-resources are synced with their parent
+	if strings.Contains(apiResource.Name, "/") {
+		return false
+	}
+	return sets.New[string](apiResource.Verbs...).HasAll(requiredVerbs...)
+}
+
+// resourceFilter matches resources against <resource>.<group> patterns.
+// "*.<group>" matches every resource of the group and of its subgroups, "*" matches everything.
+type resourceFilter struct {
+	allowed []string
+	denied  []string
+}
+
+func newResourceFilter(allowed, denied []string) resourceFilter {
+	return resourceFilter{allowed: normalizePatterns(allowed), denied: normalizePatterns(denied)}
+}
+
+func (f resourceFilter) allows(gr schema.GroupResource) bool {
+	if matchesAny(f.denied, gr) {
+		return false
+	}
+	return len(f.allowed) == 0 || matchesAny(f.allowed, gr)
+}
+
+func normalizePatterns(patterns []string) []string {
+	result := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		if p = strings.ToLower(strings.TrimSpace(p)); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func matchesAny(patterns []string, gr schema.GroupResource) bool {
+	for _, p := range patterns {
+		if matchesPattern(p, gr) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPattern(pattern string, gr schema.GroupResource) bool {
+	if pattern == "*" {
+		return true
+	}
+	if group, ok := strings.CutPrefix(pattern, "*."); ok {
+		return gr.Group == group || strings.HasSuffix(gr.Group, "."+group)
+	}
+	return schema.ParseGroupResource(pattern) == gr
+}
+
+// discoveryCacheTTL is how long the discovered resources of a cluster are reused before they are discovered again,
+// so that resources installed later (e.g. CRDs) become syncable.
+const discoveryCacheTTL = 5 * time.Minute
+
+// discoveryClients holds the memory-cached discovery clients of Karmada and the member clusters.
+var discoveryClients = newDiscoveryCache(discoveryCacheTTL)
+
+type discoveryCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	clients map[string]*cachedDiscoveryClient
+}
+
+type cachedDiscoveryClient struct {
+	discovery.CachedDiscoveryInterface
+	// host is the API server the client was created for, a cluster registered again may have another one
+	host      string
+	fetchedAt time.Time
+}
+
+func newDiscoveryCache(ttl time.Duration) *discoveryCache {
+	return &discoveryCache{ttl: ttl, now: time.Now, clients: make(map[string]*cachedDiscoveryClient)}
+}
+
+// get returns the cached discovery client of the cluster, created by newClient if there is none for host. The
+// cached resources are invalidated once they are older than the cache TTL.
+func (c *discoveryCache) get(key, host string, newClient func() (discovery.DiscoveryInterface, error)) (discovery.DiscoveryInterface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if cached, ok := c.clients[key]; ok && cached.host == host {
+		if now.Sub(cached.fetchedAt) > c.ttl {
+			cached.Invalidate()
+			cached.fetchedAt = now
+		}
+		return cached, nil
+	}
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	cached := &cachedDiscoveryClient{CachedDiscoveryInterface: memory.NewMemCacheClient(client), host: host, fetchedAt: now}
+	c.clients[key] = cached
+	return cached, nil
+}
@@ -0,0 +1,163 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestDiscoveryCache(t *testing.T) {
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	cache := newDiscoveryCache(5 * time.Minute)
+	cache.now = func() time.Time { return now }
+
+	fake := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list", "create"}}},
+	}}}}
+	created := 0
+	get := func(host string) discovery.DiscoveryInterface {
+		client, err := cache.get("member/member1", host, func() (discovery.DiscoveryInterface, error) {
+			created++
+			return fake, nil
+		})
+		if err != nil {
+			t.Fatalf("get() failed: %v", err)
+		}
+		if _, err := client.ServerResourcesForGroupVersion("apps/v1"); err != nil {
+			t.Fatalf("ServerResourcesForGroupVersion() failed: %v", err)
+		}
+		return client
+	}
+
+	get("https://10.0.0.1:6443")
+	discovered := len(fake.Actions())
+	get("https://10.0.0.1:6443")
+	if created != 1 || len(fake.Actions()) != discovered {
+		t.Errorf("discovery ran again on the second request: %d clients, %d actions, expected 1 client and %d actions", created, len(fake.Actions()), discovered)
+	}
+
+	// the resources are discovered again once the TTL expired
+	now = now.Add(6 * time.Minute)
+	get("https://10.0.0.1:6443")
+	if created != 1 || len(fake.Actions()) == discovered {
+		t.Errorf("discovery did not run again after the TTL: %d clients, %d actions", created, len(fake.Actions()))
+	}
+
+	// a cluster registered again with another API server gets a new client
+	get("https://10.0.0.2:6443")
+	if created != 2 {
+		t.Errorf("%d clients created after the host changed, expected 2", created)
+	}
+}
+
+func TestResourceFilter(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	configMaps := schema.GroupResource{Resource: "configmaps"}
+	ingresses := schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}
+	policies := schema.GroupResource{Group: "policy.karmada.io", Resource: "propagationpolicies"}
+	cases := []struct {
+		allowed  []string
+		denied   []string
+		resource schema.GroupResource
+		expected bool
+	}{
+		{nil, nil, deployments, true},
+		{[]string{"deployments.apps"}, nil, deployments, true},
+		{[]string{" Deployments.Apps "}, nil, deployments, true},
+		{[]string{"deployments.apps"}, nil, configMaps, false},
+		{[]string{"configmaps"}, nil, configMaps, true},
+		{[]string{"*.k8s.io"}, nil, ingresses, true},
+		{[]string{"*.k8s.io"}, nil, deployments, false},
+		{[]string{"*.karmada.io"}, nil, policies, true},
+		{[]string{"*"}, nil, configMaps, true},
+		{nil, []string{"*.karmada.io"}, policies, false},
+		{nil, []string{"*.karmada.io"}, deployments, true},
+		// denied takes precedence over allowed
+		{[]string{"*"}, []string{"configmaps"}, configMaps, false},
+		{[]string{"deployments.apps"}, []string{"*.apps"}, deployments, false},
+		{[]string{""}, nil, configMaps, true},
+	}
+	for _, c := range cases {
+		if actual := newResourceFilter(c.allowed, c.denied).allows(c.resource); actual != c.expected {
+			t.Errorf("allows(%v) with allowed %q and denied %q == %t, expected %t", c.resource, c.allowed, c.denied, actual, c.expected)
+		}
+	}
+}
+
+// preferredDiscovery serves its resources as the preferred resources, which the fake discovery client does not.
+type preferredDiscovery struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (d preferredDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.Resources, nil
+}
+
+func newPreferredDiscovery(resources ...*metav1.APIResourceList) preferredDiscovery {
+	return preferredDiscovery{&fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: resources}}}
+}
+
+func TestDiscoverSyncResourceKinds(t *testing.T) {
+	verbs := []string{"get", "list", "create", "update"}
+	events := &metav1.APIResourceList{GroupVersion: "v1", APIResources: []metav1.APIResource{
+		{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: verbs},
+		{Name: "events", Kind: "Event", Namespaced: true, Verbs: verbs},
+		{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: verbs},
+		{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+		{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+	}}
+	k8sEvents := &metav1.APIResourceList{GroupVersion: "events.k8s.io/v1", APIResources: []metav1.APIResource{
+		{Name: "events", Kind: "Event", Namespaced: true, Verbs: verbs},
+	}}
+	apps := &metav1.APIResourceList{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+		{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: verbs},
+	}}
+	karmadaPolicies := &metav1.APIResourceList{GroupVersion: "policy.karmada.io/v1alpha1", APIResources: []metav1.APIResource{
+		{Name: "propagationpolicies", Kind: "PropagationPolicy", Namespaced: true, Verbs: verbs},
+	}}
+	widgets := &metav1.APIResourceList{GroupVersion: "example.com/v1", APIResources: []metav1.APIResource{
+		{Name: "widgets", Kind: "Widget", Namespaced: true, Verbs: verbs},
+	}}
+	// the member cluster serves widgets with another version and does not serve the karmada policies
+	memberWidgets := &metav1.APIResourceList{GroupVersion: "example.com/v2", APIResources: widgets.APIResources}
+
+	previousAllowed, previousDenied := intra.Env.SyncAllowedResources, intra.Env.SyncDeniedResources
+	defer func() {
+		intra.Env.SyncAllowedResources, intra.Env.SyncDeniedResources = previousAllowed, previousDenied
+	}()
+	intra.Env.SyncAllowedResources = nil
+	intra.Env.SyncDeniedResources = []string{"pods"}
+
+	kinds, err := DiscoverSyncResourceKinds(
+		newPreferredDiscovery(events, k8sEvents, apps, memberWidgets),
+		newPreferredDiscovery(events, k8sEvents, apps, karmadaPolicies, widgets),
+	)
+	if err != nil {
+		t.Fatalf("DiscoverSyncResourceKinds() failed: %v", err)
+	}
+	names := make([]string, 0)
+	for _, res := range kinds.List() {
+		names = append(names, res.Kind)
+	}
+	// the event kind is claimed by the core group, the events of events.k8s.io are qualified
+	expected := []string{"configmap", "deployment", "event", "events.events.k8s.io"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("DiscoverSyncResourceKinds() == %v, expected %v", names, expected)
+	}
+	for kind, group := range map[string]string{"event": "", "events.events.k8s.io": "events.k8s.io", "events": "", "deployments.apps": "apps"} {
+		if res, ok := kinds.Lookup(kind); !ok || res.Group != group {
+			t.Errorf("Lookup(%s) == %#v, %t expected the resource of group %q", kind, res, ok, group)
+		}
+	}
+	if _, ok := kinds.Lookup("widget"); ok {
+		t.Errorf("Lookup(widget) found a resource served with another version by the member cluster")
+	}
+}
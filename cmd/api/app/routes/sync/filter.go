@@ -0,0 +1,100 @@
+package sync
+
+import (
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Helm marks the objects of a release with this label and annotation.
+const (
+	helmManagedByLabel        = "app.kubernetes.io/managed-by"
+	helmManagedByValue        = "Helm"
+	helmReleaseNameAnnotation = "meta.helm.sh/release-name"
+)
+
+// syncFilter decides which objects of a member cluster are sync candidates.
+type syncFilter struct {
+	selector labels.Selector
+	// excludeOwned excludes objects with a controller owner reference and objects of Helm releases
+	excludeOwned bool
+	// includeKarmadaManaged includes objects propagated to the member cluster by Karmada
+	includeKarmadaManaged bool
+	// dependencies are the objects added by includeDependencies, they are synced for the workloads
+	// requiring them and are not filtered
+	dependencies sets.Set[string]
+}
+
+func newSyncFilter(labelSelector string, excludeOwned, includeKarmadaManaged bool) (*syncFilter, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &syncFilter{
+		selector:              selector,
+		excludeOwned:          excludeOwned,
+		includeKarmadaManaged: includeKarmadaManaged,
+		dependencies:          sets.New[string](),
+	}, nil
+}
+
+// exemptDependencies excludes the resolved dependencies from the filter.
+func (f *syncFilter) exemptDependencies(dependencies []SyncDependency) {
+	for _, dep := range dependencies {
+		f.dependencies.Insert(dependencyKey(dep.Namespace, dep.Kind, dep.Name))
+	}
+}
+
+// isDependency reports whether the object of the kind was added to the sync as a dependency.
+func (f *syncFilter) isDependency(namespace, kind, name string) bool {
+	return f.dependencies.Has(dependencyKey(namespace, kind, name))
+}
+
+func dependencyKey(namespace, kind, name string) string {
+	return namespace + "/" + kind + "/" + name
+}
+
+// excludeReason returns the message key telling why obj is not a sync candidate, or "" if it is one.
+func (f *syncFilter) excludeReason(obj *unstructured.Unstructured) string {
+	if !f.selector.Matches(labels.Set(obj.GetLabels())) {
+		return msgkey.SyncExcludedByLabelSelector
+	}
+	if f.excludeOwned && isOwned(obj) {
+		return msgkey.SyncExcludedOwnedResource
+	}
+	if !f.includeKarmadaManaged && isKarmadaManaged(obj) {
+		return msgkey.SyncExcludedKarmadaManaged
+	}
+	return ""
+}
+
+// isOwned reports whether obj is managed by a controller or a Helm release. An owner reference that is not a
+// controller reference only ties the garbage collection of obj to its owner, and obj is still a candidate.
+func isOwned(obj *unstructured.Unstructured) bool {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller {
+			return true
+		}
+	}
+	if obj.GetLabels()[helmManagedByLabel] == helmManagedByValue {
+		return true
+	}
+	_, ok := obj.GetAnnotations()[helmReleaseNameAnnotation]
+	return ok
+}
+
+// isKarmadaManaged reports whether obj carries the labels or annotations Karmada sets on propagated objects.
+func isKarmadaManaged(obj *unstructured.Unstructured) bool {
+	for key := range obj.GetLabels() {
+		if matchesKey(karmadaKeys, key) {
+			return true
+		}
+	}
+	for key := range obj.GetAnnotations() {
+		if matchesKey(karmadaKeys, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSyncFilterExcludeReason(t *testing.T) {
+	controller := true
+	withMeta := func(labels, annotations map[string]string, owners ...metav1.OwnerReference) *unstructured.Unstructured {
+		obj := configMap("web", nil)
+		obj.SetLabels(labels)
+		obj.SetAnnotations(annotations)
+		obj.SetOwnerReferences(owners)
+		return obj
+	}
+	replicaSet := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-7d9f4c", UID: "1c2e", Controller: &controller}
+	garbageCollected := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "settings", UID: "9a4b"}
+
+	cases := []struct {
+		name                  string
+		selector              string
+		excludeOwned          bool
+		includeKarmadaManaged bool
+		obj                   *unstructured.Unstructured
+		expected              string
+	}{
+		{"plain", "", true, false, withMeta(nil, nil), ""},
+		{"selected", "app=payments", false, false, withMeta(map[string]string{"app": "payments"}, nil), ""},
+		{"not selected", "app=payments", false, false, withMeta(map[string]string{"app": "web"}, nil), msgkey.SyncExcludedByLabelSelector},
+		{"controller owner", "", true, false, withMeta(nil, nil, replicaSet), msgkey.SyncExcludedOwnedResource},
+		{"controller owner kept", "", false, false, withMeta(nil, nil, replicaSet), ""},
+		{"non-controller owner", "", true, false, withMeta(nil, nil, garbageCollected), ""},
+		{"helm label", "", true, false, withMeta(map[string]string{helmManagedByLabel: helmManagedByValue}, nil), msgkey.SyncExcludedOwnedResource},
+		{"other manager", "", true, false, withMeta(map[string]string{helmManagedByLabel: "kustomize"}, nil), ""},
+		{"helm annotation", "", true, false, withMeta(nil, map[string]string{helmReleaseNameAnnotation: "payments"}), msgkey.SyncExcludedOwnedResource},
+		{"karmada label", "", false, false, withMeta(map[string]string{"propagationpolicy.karmada.io/name": "web"}, nil), msgkey.SyncExcludedKarmadaManaged},
+		{"karmada annotation", "", false, false, withMeta(nil, map[string]string{"resourcetemplate.karmada.io/uid": "3f1e"}), msgkey.SyncExcludedKarmadaManaged},
+		{"karmada included", "", false, true, withMeta(map[string]string{"propagationpolicy.karmada.io/name": "web"}, nil), ""},
+	}
+	for _, c := range cases {
+		filter, err := newSyncFilter(c.selector, c.excludeOwned, c.includeKarmadaManaged)
+		if err != nil {
+			t.Fatalf("newSyncFilter(%q) failed: %v", c.selector, err)
+		}
+		if actual := filter.excludeReason(c.obj); actual != c.expected {
+			t.Errorf("excludeReason(%s) == %q, expected %q", c.name, actual, c.expected)
+		}
+	}
+}
+
+func TestNewSyncFilterInvalidSelector(t *testing.T) {
+	if _, err := newSyncFilter("app in (payments", false, false); err == nil {
+		t.Errorf("newSyncFilter() with an invalid selector succeeded, expected an error")
+	}
+}
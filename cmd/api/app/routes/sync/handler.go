@@ -7,19 +7,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/dashboard/cmd/api/app/adapter/federation"
 	apperrors "github.com/karmada-io/dashboard/cmd/api/app/errors"
+	"github.com/karmada-io/dashboard/cmd/api/app/localize"
 	"github.com/karmada-io/dashboard/cmd/api/app/response"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
-	appsv1 "k8s.io/api/apps/v1"
-	batch "k8s.io/api/batch/v1"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/cluster"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"log"
+	"slices"
+	"strconv"
 )
 
 type Handler struct {
@@ -33,19 +32,38 @@ func NewHandler(adapter federation.FederationAdapter) *Handler {
 }
 
 type SyncResource struct {
-	Name         string `json:"name"`
-	IsDuplicated bool   `json:"isDuplicated"`
+	Name string `json:"name"`
+	// IsDuplicated is set if an object with the same name exists in Karmada
+	IsDuplicated bool `json:"isDuplicated"`
+	// Comparison with the Karmada object: identical, differs or absent
+	Comparison string `json:"comparison"`
+	// DiffPaths are the fields that differ from the Karmada object
+	DiffPaths []string `json:"diffPaths,omitempty"`
 }
 
 type SyncRequest struct {
 	CreateNamespaces []string          `json:"createNamespace"`
 	Data             []SyncRequestData `json:"data"`
+	// ConflictStrategy is applied to objects that already exist in Karmada: skip, overwrite or rename
+	ConflictStrategy string `json:"conflictStrategy"`
+	// RenameSuffix is appended to the names of renamed objects, "-synced" by default
+	RenameSuffix string `json:"renameSuffix"`
+	// CreatePropagationPolicy generates a policy per namespace that propagates the synced objects
+	// back to the source cluster and adopts the originals, renamed objects are not propagated
+	CreatePropagationPolicy bool `json:"createPropagationPolicy"`
+	// LabelSelector, ExcludeOwned and IncludeKarmadaManaged filter the requested objects,
+	// objects not passing the filter are skipped
+	LabelSelector         string `json:"labelSelector"`
+	ExcludeOwned          bool   `json:"excludeOwned"`
+	IncludeKarmadaManaged bool   `json:"includeKarmadaManaged"`
 }
 
 type SyncResponse struct {
-	TotalResource   int `json:"totalResource"`
-	FailResource    int `json:"failResource"`
-	SuccessResource int `json:"successResource"`
+	TotalResource   int              `json:"totalResource"`
+	FailResource    int              `json:"failResource"`
+	SuccessResource int              `json:"successResource"`
+	SkipResource    int              `json:"skipResource"`
+	Items           []SyncResultItem `json:"items"`
 }
 
 type SyncRequestData struct {
@@ -57,19 +75,20 @@ type SyncRequestResource struct {
 	List []string `json:"list"`
 }
 
-func BuildSyncResourceList[T NamedResource](clusterItems, karmadaItems []T) []SyncResource {
+func BuildSyncResourceList(clusterItems, karmadaItems []*unstructured.Unstructured) []SyncResource {
+	karmadaByName := make(map[string]*unstructured.Unstructured, len(karmadaItems))
+	for _, k := range karmadaItems {
+		karmadaByName[k.GetName()] = k
+	}
+
 	var result []SyncResource
 	for _, c := range clusterItems {
-		isDuplicated := false
-		for _, k := range karmadaItems {
-			if c.GetName() == k.GetName() {
-				isDuplicated = true
-				break
-			}
-		}
+		comparison, diffPaths := compareObjects(c, karmadaByName[c.GetName()])
 		result = append(result, SyncResource{
 			Name:         c.GetName(),
-			IsDuplicated: isDuplicated,
+			IsDuplicated: comparison != ComparisonAbsent,
+			Comparison:   comparison,
+			DiffPaths:    diffPaths,
 		})
 	}
 	return result
@@ -83,64 +102,16 @@ func ToPtrSlice[T any](items []T) []*T {
 	return ptrs
 }
 
-var kindToGVR = map[string]schema.GroupVersionResource{
-	"namespace": {
-		Group:    "",
-		Version:  "v1",
-		Resource: "namespaces",
-	},
-	"deployment": {
-		Group:    "apps",
-		Version:  "v1",
-		Resource: "deployments",
-	},
-	"statefulset": {
-		Group:    "apps",
-		Version:  "v1",
-		Resource: "statefulsets",
-	},
-	"daemonset": {
-		Group:    "apps",
-		Version:  "v1",
-		Resource: "daemonsets",
-	},
-	"job": {
-		Group:    "batch",
-		Version:  "v1",
-		Resource: "jobs",
-	},
-	"cronjob": {
-		Group:    "batch",
-		Version:  "v1",
-		Resource: "cronjobs",
-	},
-	"configmap": {
-		Group:    "",
-		Version:  "v1",
-		Resource: "configmaps",
-	},
-	"secret": {
-		Group:    "",
-		Version:  "v1",
-		Resource: "secrets",
-	},
-}
-
-func listK8sResources(kind string, namespace string, dynClient dynamic.Interface) ([]unstructured.Unstructured, error) {
-	gvr, ok := kindToGVR[kind]
-	if !ok {
-		return nil, nil
-	}
-
+func listK8sResources(res SyncResourceKind, namespace string, dynClient dynamic.Interface) ([]unstructured.Unstructured, error) {
 	var resources *unstructured.UnstructuredList
 	var err error
-	if kind == "namespace" {
-		resources, err = dynClient.Resource(gvr).List(context.TODO(), metav1.ListOptions{})
+	if !res.Namespaced {
+		resources, err = dynClient.Resource(res.GVR()).List(context.TODO(), metav1.ListOptions{})
 	} else {
 		if namespace == "" {
 			return nil, fmt.Errorf("require namespace")
 		}
-		resources, err = dynClient.Resource(gvr).Namespace(namespace).List(context.TODO(), metav1.ListOptions{})
+		resources, err = dynClient.Resource(res.GVR()).Namespace(namespace).List(context.TODO(), metav1.ListOptions{})
 	}
 	if err != nil {
 		return nil, err
@@ -149,234 +120,140 @@ func listK8sResources(kind string, namespace string, dynClient dynamic.Interface
 	return resources.Items, nil
 }
 
-type NamedResource interface {
-	GetName() string
+// resourceClient returns the client for res, scoped to namespace if res is namespaced.
+func resourceClient(dynClient dynamic.Interface, res SyncResourceKind, namespace string) dynamic.ResourceInterface {
+	if res.Namespaced {
+		return dynClient.Resource(res.GVR()).Namespace(namespace)
+	}
+	return dynClient.Resource(res.GVR())
 }
 
-func ConvertUnstructuredList[T any](items []unstructured.Unstructured) ([]T, error) {
-	var results []T
-	for _, item := range items {
-		var result T
-		err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &result)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert: %w", err)
-		}
-		results = append(results, result)
+// memberClients returns the dynamic and the cached discovery clients of the member cluster.
+func (h Handler) memberClients(clusterID string) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+	credential, err := h.Adapter.GetKubeAccessInfo(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberClusterRestConfig := common.LoadRestConfigFromBearerToken(credential.APIServerURL, credential.BearerToken)
+	dynClient, err := dynamic.NewForConfig(memberClusterRestConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	discoveryClient, err := discoveryClients.get("member/"+clusterID, memberClusterRestConfig.Host, func() (discovery.DiscoveryInterface, error) {
+		return discovery.NewDiscoveryClientForConfig(memberClusterRestConfig)
+	})
+	if err != nil {
+		return nil, nil, err
 	}
-	return results, nil
+	return dynClient, discoveryClient, nil
 }
 
-func (h Handler) HandleGetSyncResources(c *gin.Context) {
-	kind := c.Query("kind")
+// karmadaDiscoveryKey is the discovery cache key of Karmada, the member clusters are cached by "member/<cluster id>".
+const karmadaDiscoveryKey = "karmada"
 
-	if _, ok := kindToGVR[kind]; !ok {
-		log.Printf("not currnet kind request")
+// syncResourceKinds discovers the resources that can be synced from the member cluster into Karmada.
+func syncResourceKinds(memberDiscovery discovery.DiscoveryInterface) (*SyncResourceKinds, error) {
+	karmadaClient := client.InClusterClientForKarmadaAPIServer()
+	if karmadaClient == nil {
+		return nil, fmt.Errorf("karmada client is not initialized")
+	}
+	karmadaDiscovery, err := discoveryClients.get(karmadaDiscoveryKey, "", func() (discovery.DiscoveryInterface, error) {
+		return karmadaClient.Discovery(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DiscoverSyncResourceKinds(memberDiscovery, karmadaDiscovery)
+}
+
+func (h Handler) HandleGetSyncResourceKinds(c *gin.Context) {
+	ClusterID := c.Param("clusterId")
+
+	_, memberDiscovery, err := h.memberClients(ClusterID)
+	if err != nil {
+		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
+	kinds, err := syncResourceKinds(memberDiscovery)
+	if err != nil {
+		log.Printf("failed request: %v", err)
+		response.FailedWithError(c, apperrors.FailedRequest)
+		return
+	}
+	response.Success(c, kinds.List())
+}
+
+func (h Handler) HandleGetSyncResources(c *gin.Context) {
+	kind := c.Query("kind")
 	ClusterID := c.Param("clusterId")
 	namespace := c.Query("namespace")
+	excludeOwned, err := strconv.ParseBool(c.DefaultQuery("excludeOwned", "false"))
+	if err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	includeKarmadaManaged, err := strconv.ParseBool(c.DefaultQuery("includeKarmadaManaged", "false"))
+	if err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	filter, err := newSyncFilter(c.Query("labelSelector"), excludeOwned, includeKarmadaManaged)
+	if err != nil {
+		log.Printf("invalid label selector: %v", err)
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
 
-	karmadaKubeClient := client.InClusterDynamicClientForKarmadaAPIServer()
-	karmadaResourceList, err := listK8sResources(kind, namespace, karmadaKubeClient)
+	clusterKubeClient, memberDiscovery, err := h.memberClients(ClusterID)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
-
-	credential, err := h.Adapter.GetKubeAccessInfo(ClusterID)
+	kinds, err := syncResourceKinds(memberDiscovery)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
-	memberClusterRestConfig := common.LoadRestConfigFromBearerToken(credential.APIServerURL, credential.BearerToken)
-	clusterKubeClient, err := dynamic.NewForConfig(memberClusterRestConfig)
+	res, ok := kinds.Lookup(kind)
+	if !ok {
+		log.Printf("unsupported kind(%v)", kind)
+		response.FailedWithError(c, apperrors.UnsupportedResourceKind)
+		return
+	}
+	if res.Namespaced && namespace == "" {
+		response.FailedWithError(c, apperrors.ResourceNamespaceRequired)
+		return
+	}
+
+	karmadaKubeClient := client.InClusterDynamicClientForKarmadaAPIServer()
+	karmadaResourceList, err := listK8sResources(res, namespace, karmadaKubeClient)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
-	clusterResourceList, err := listK8sResources(kind, namespace, clusterKubeClient)
-
+	clusterResourceList, err := listK8sResources(res, namespace, clusterKubeClient)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
-	} else {
-		switch kind {
-		case "deployment":
-			var kResults []appsv1.Deployment
-			var cResults []appsv1.Deployment
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[appsv1.Deployment](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[appsv1.Deployment](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "namespace":
-			var kResults []corev1.Namespace
-			var cResults []corev1.Namespace
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[corev1.Namespace](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[corev1.Namespace](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "statefulset":
-			var kResults []appsv1.StatefulSet
-			var cResults []appsv1.StatefulSet
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[appsv1.StatefulSet](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[appsv1.StatefulSet](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "daemonset":
-			var kResults []appsv1.DaemonSet
-			var cResults []appsv1.DaemonSet
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[appsv1.DaemonSet](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[appsv1.DaemonSet](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "job":
-			var kResults []batch.Job
-			var cResults []batch.Job
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[batch.Job](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[batch.Job](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "cronjob":
-			var kResults []batch.CronJob
-			var cResults []batch.CronJob
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[batch.CronJob](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[batch.CronJob](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "configmap":
-			var kResults []corev1.ConfigMap
-			var cResults []corev1.ConfigMap
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[corev1.ConfigMap](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[corev1.ConfigMap](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
-		case "secret":
-			var kResults []corev1.Secret
-			var cResults []corev1.Secret
-			var syncResourceList []SyncResource
-			kResults, err = ConvertUnstructuredList[corev1.Secret](karmadaResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			cResults, err = ConvertUnstructuredList[corev1.Secret](clusterResourceList)
-			if err != nil {
-				log.Printf("failed request: %v", err)
-				response.FailedWithError(c, apperrors.FailedRequest)
-				return
-			}
-			syncResourceList = BuildSyncResourceList(ToPtrSlice(cResults), ToPtrSlice(kResults))
-			if syncResourceList == nil {
-				syncResourceList = make([]SyncResource, 0)
-			}
-			response.Success(c, syncResourceList)
+	}
+
+	candidates := make([]unstructured.Unstructured, 0, len(clusterResourceList))
+	for i := range clusterResourceList {
+		if filter.excludeReason(&clusterResourceList[i]) == "" {
+			candidates = append(candidates, clusterResourceList[i])
 		}
+	}
 
+	syncResourceList := BuildSyncResourceList(ToPtrSlice(candidates), ToPtrSlice(karmadaResourceList))
+	if syncResourceList == nil {
+		syncResourceList = make([]SyncResource, 0)
 	}
+	response.Success(c, syncResourceList)
 }
 
 func (h Handler) HandlePostSync(c *gin.Context) {
@@ -389,141 +266,242 @@ func (h Handler) HandlePostSync(c *gin.Context) {
 		return
 	}
 
-	var TotalResource = 0
-	var FailResource = 0
-	var SuccessResource = 0
+	if !isValidConflictStrategy(SyncRequests.ConflictStrategy) {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	filter, err := newSyncFilter(SyncRequests.LabelSelector, SyncRequests.ExcludeOwned, SyncRequests.IncludeKarmadaManaged)
+	if err != nil {
+		log.Printf("invalid label selector: %v", err)
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	includeDependencies, err := strconv.ParseBool(c.DefaultQuery("includeDependencies", "false"))
+	if err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
 
 	karmadaDynamicKubeClient := client.InClusterDynamicClientForKarmadaAPIServer()
 
-	credential, err := h.Adapter.GetKubeAccessInfo(ClusterID)
+	clusterKubeClient, memberDiscovery, err := h.memberClients(ClusterID)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
-
-	memberClusterRestConfig := common.LoadRestConfigFromBearerToken(credential.APIServerURL, credential.BearerToken)
-	clusterKubeClient, err := dynamic.NewForConfig(memberClusterRestConfig)
+	kinds, err := syncResourceKinds(memberDiscovery)
 	if err != nil {
 		log.Printf("failed request: %v", err)
 		response.FailedWithError(c, apperrors.FailedRequest)
 		return
 	}
 
-	TotalResource = TotalResource + len(SyncRequests.CreateNamespaces)
-	for _, createNs := range SyncRequests.CreateNamespaces {
-		gvr1, _ := kindToGVR["namespace"]
-		_, err := karmadaDynamicKubeClient.Resource(gvr1).Get(context.TODO(), createNs, metav1.GetOptions{})
+	var dependencies []SyncDependency
+	if includeDependencies {
+		resolver := &dependencyResolver{
+			ctx:    c.Request.Context(),
+			member: clusterKubeClient,
+			kinds:  kinds,
+		}
+		SyncRequests, dependencies = resolver.resolve(SyncRequests)
+		filter.exemptDependencies(dependencies)
+	}
 
-		if errors.IsNotFound(err) {
-			srcObj, err := clusterKubeClient.Resource(gvr1).Get(context.TODO(), createNs, metav1.GetOptions{})
-			if err != nil {
-				// Namespace 생성 실패
-				log.Printf("namespace create fail(%v): %v", createNs, err)
-				FailResource++
-				continue
-			}
-			objCopy := srcObj.DeepCopy()
-			unstructured.RemoveNestedField(objCopy.Object, "metadata", "resourceVersion")
-			unstructured.RemoveNestedField(objCopy.Object, "metadata", "uid")
-			unstructured.RemoveNestedField(objCopy.Object, "metadata", "creationTimestamp")
-			unstructured.RemoveNestedField(objCopy.Object, "metadata", "managedFields")
-			unstructured.RemoveNestedField(objCopy.Object, "metadata", "generation")
-
-			_, err = karmadaDynamicKubeClient.Resource(gvr1).Create(context.TODO(), objCopy, metav1.CreateOptions{})
-			if err != nil {
-				// Namespace 생성 실패
-				log.Printf("namespace create fail(%v): %v", createNs, err)
-				FailResource++
-			} else {
-				log.Printf("namespace create success(%v)", createNs)
-				SuccessResource++
-			}
-		} else if err == nil {
-			log.Printf("namespace create fail(%v): (already existed)", createNs)
-			FailResource++
-		} else {
-			log.Printf("namespace create fail(%v) : %v", createNs, err)
-			FailResource++
+	if dryRun {
+		dryRunVerber, err := client.VerberClientWithOptions(c.Request, client.VerberOptions{DryRun: true})
+		if err != nil {
+			log.Printf("failed request: %v", err)
+			response.FailedWithError(c, apperrors.FailedRequest)
+			return
+		}
+		previewer := &syncPreviewer{
+			ctx:     c.Request.Context(),
+			member:  clusterKubeClient,
+			karmada: karmadaDynamicKubeClient,
+			verber:  dryRunVerber,
+			kinds:   kinds,
+			filter:  filter,
+			localize: func(key string) string {
+				return localize.GetLocalizeMessage(c, key)
+			},
+		}
+		preview := previewer.preview(SyncRequests)
+		preview.Dependencies = dependencies
+		response.Success(c, preview)
+		return
+	}
+
+	verber, err := client.VerberClient(c.Request)
+	if err != nil {
+		log.Printf("failed request: %v", err)
+		response.FailedWithError(c, apperrors.FailedRequest)
+		return
+	}
+	var policyGenerator *syncPolicyGenerator
+	if SyncRequests.CreatePropagationPolicy {
+		karmadaClient := client.InClusterKarmadaClient()
+		clusterName, err := cluster.GetClusterNameByID(karmadaClient, ClusterID)
+		if err != nil {
+			log.Printf("failed request: %v", err)
+			response.FailedWithError(c, apperrors.ClusterNotFoundInKarmada)
+			return
+		}
+		policyGenerator = &syncPolicyGenerator{
+			ctx:          context.Background(),
+			karmada:      karmadaClient,
+			clusterNames: []string{clusterName},
 		}
 	}
+	report := newSyncReport(ClusterID, SyncRequests)
+	report.Dependencies = dependencies
+	if policyGenerator != nil {
+		policyGenerator.name = syncPolicyName(report.ID)
+	}
+	// the job is not bound to the request, it keeps running if the client disconnects
+	runner := &syncRunner{
+		ctx:     context.Background(),
+		member:  clusterKubeClient,
+		karmada: karmadaDynamicKubeClient,
+		verber:  verber,
+		kinds:   kinds,
+		filter:  filter,
+		request: SyncRequests,
+	}
+	job := startSyncJob(report, runner, policyGenerator)
+	response.Accepted(c, job)
+}
 
-	for _, nsRes := range SyncRequests.Data {
+func (h Handler) HandleGetSyncJob(c *gin.Context) {
+	job, ok := syncJobs.get(c.Param("jobId"))
+	if !ok {
+		response.FailedWithError(c, apperrors.SyncJobNotFound)
+		return
+	}
+	response.Success(c, localizeJob(c, job))
+}
 
-		gvr1, _ := kindToGVR["namespace"]
-		_, err := karmadaDynamicKubeClient.Resource(gvr1).Get(context.TODO(), nsRes.Namespace, metav1.GetOptions{})
+// HandleDeleteSyncJob cancels a running sync job, it stops before the next object.
+// A finished job is returned as it is.
+func (h Handler) HandleDeleteSyncJob(c *gin.Context) {
+	job, ok := syncJobs.cancel(c.Param("jobId"))
+	if !ok {
+		response.FailedWithError(c, apperrors.SyncJobNotFound)
+		return
+	}
+	response.Success(c, localizeJob(c, job))
+}
 
-		if errors.IsNotFound(err) {
-			//리소스를 만들수 없으므로 일괄 에러처리
-			var failCount = 0
-			for _, List := range nsRes.List {
-				TotalResource = TotalResource + len(List.List)
-				FailResource = FailResource + len(List.List)
-				failCount++
-			}
-			log.Printf("resources create fail: namespace that doesn't exist(%v), fail count : %v", nsRes.Namespace, failCount)
-			continue
-		} else {
-			for _, kindGroup := range nsRes.List {
-				TotalResource = TotalResource + len(kindGroup.List)
-				gvr, ok := kindToGVR[kindGroup.Kind]
-				if !ok {
-					// 지원하지 않는 kind
-					log.Printf("unsupported kind(%v)", kindGroup.Kind)
-					FailResource = FailResource + len(kindGroup.List)
-					continue
-				}
-
-				for _, name := range kindGroup.List {
-					// 원본 리소스 가져오기
-					srcObj, err := clusterKubeClient.Resource(gvr).Namespace(nsRes.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
-					if err != nil {
-						// 리소스 가져오기 실패
-						log.Printf("%v create fail(%v - %v) : %v", kindGroup.Kind, nsRes.Namespace, name, err)
-						FailResource++
-						continue
-					}
-					objCopy := srcObj.DeepCopy()
-					unstructured.RemoveNestedField(objCopy.Object, "metadata", "resourceVersion")
-					unstructured.RemoveNestedField(objCopy.Object, "metadata", "uid")
-					unstructured.RemoveNestedField(objCopy.Object, "metadata", "creationTimestamp")
-					unstructured.RemoveNestedField(objCopy.Object, "metadata", "managedFields")
-					unstructured.RemoveNestedField(objCopy.Object, "metadata", "generation")
-
-					if gvr.Resource == "jobs" {
-						unstructured.RemoveNestedField(objCopy.Object, "metadata", "labels", "controller-uid")
-						unstructured.RemoveNestedField(objCopy.Object, "metadata", "labels", "job-name")
-						unstructured.RemoveNestedField(objCopy.Object, "metadata", "labels", "batch.kubernetes.io/controller-uid")
-						unstructured.RemoveNestedField(objCopy.Object, "metadata", "labels", "batch.kubernetes.io/job-name")
-
-						unstructured.RemoveNestedField(objCopy.Object, "spec", "selector")
-
-						unstructured.RemoveNestedField(objCopy.Object, "spec", "template", "metadata", "labels", "controller-uid")
-						unstructured.RemoveNestedField(objCopy.Object, "spec", "template", "metadata", "labels", "job-name")
-						unstructured.RemoveNestedField(objCopy.Object, "spec", "template", "metadata", "labels", "batch.kubernetes.io/controller-uid")
-						unstructured.RemoveNestedField(objCopy.Object, "spec", "template", "metadata", "labels", "batch.kubernetes.io/job-name")
-					}
-
-					_, err = karmadaDynamicKubeClient.Resource(gvr).Namespace(nsRes.Namespace).Create(context.TODO(), objCopy, metav1.CreateOptions{})
-					if err != nil {
-						if errors.IsAlreadyExists(err) {
-							// Karmada에 이미 리소스 존재함
-							log.Printf("%v create fail(%v - %v) : %v", kindGroup.Kind, nsRes.Namespace, name, err)
-							FailResource++
-						} else {
-							// Karmada에 Sync 실패
-							log.Printf("%v create fail(%v - %v) : %v", kindGroup.Kind, nsRes.Namespace, name, err)
-							FailResource++
-						}
-					} else {
-						// Karmada에 Sync 성공
-						log.Printf("%v create success(%v - %v)", kindGroup.Kind, nsRes.Namespace, name)
-						SuccessResource++
-
-					}
-				}
+func (h Handler) HandleGetSyncReports(c *gin.Context) {
+	ClusterID := c.Param("clusterId")
+	reports := syncReports.list(ClusterID)
+	for i := range reports {
+		reports[i] = localizeReport(c, reports[i])
+	}
+	response.Success(c, reports)
+}
+
+func (h Handler) HandleGetSyncReport(c *gin.Context) {
+	report, ok := syncReports.get(c.Param("clusterId"), c.Param("reportId"))
+	if !ok {
+		response.FailedWithError(c, apperrors.SyncReportNotFound)
+		return
+	}
+	response.Success(c, localizeReport(c, report))
+}
+
+// HandlePostMergeSync merges the same namespace of several member clusters into one Karmada template per object.
+// The merge runs as a sync job whose result is in the job once it is finished, a dry run returns the preview.
+func (h Handler) HandlePostMergeSync(c *gin.Context) {
+	var mergeRequest MergeSyncRequest
+	err := json.NewDecoder(c.Request.Body).Decode(&mergeRequest)
+	if err != nil {
+		log.Printf("failed request: %v", err)
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	clusterIDs := make([]string, 0, len(mergeRequest.ClusterIds))
+	for _, clusterID := range mergeRequest.ClusterIds {
+		if !slices.Contains(clusterIDs, clusterID) {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	mergeRequest.ClusterIds = clusterIDs
+	if len(mergeRequest.ClusterIds) < 2 || mergeRequest.Namespace == "" || len(mergeRequest.List) == 0 ||
+		!isValidMergeConflictStrategy(mergeRequest.ConflictStrategy) {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	filter, err := newSyncFilter(mergeRequest.LabelSelector, mergeRequest.ExcludeOwned, mergeRequest.IncludeKarmadaManaged)
+	if err != nil {
+		log.Printf("invalid label selector: %v", err)
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		response.FailedWithError(c, apperrors.RequestValueInvalid)
+		return
+	}
+
+	// a merge that writes to Karmada runs as a job, it is not bound to the request and keeps running if the
+	// client disconnects
+	ctx := context.Background()
+	if dryRun {
+		ctx = c.Request.Context()
+	}
+	merger := &syncMerger{
+		ctx:     ctx,
+		karmada: client.InClusterDynamicClientForKarmadaAPIServer(),
+		filter:  filter,
+		request: mergeRequest,
+		dryRun:  dryRun,
+	}
+	generatePolicies := mergeRequest.CreatePropagationPolicy || mergeRequest.CreateOverridePolicy
+	if generatePolicies {
+		merger.policyClient = client.InClusterKarmadaClient()
+	}
+	for _, clusterID := range mergeRequest.ClusterIds {
+		clusterKubeClient, memberDiscovery, err := h.memberClients(clusterID)
+		if err != nil {
+			log.Printf("failed request: %v", err)
+			response.FailedWithError(c, apperrors.FailedRequest)
+			return
+		}
+		kinds, err := syncResourceKinds(memberDiscovery)
+		if err != nil {
+			log.Printf("failed request: %v", err)
+			response.FailedWithError(c, apperrors.FailedRequest)
+			return
+		}
+		mergeCluster := mergeCluster{id: clusterID, client: clusterKubeClient, kinds: kinds}
+		if generatePolicies {
+			mergeCluster.name, err = cluster.GetClusterNameByID(merger.policyClient, clusterID)
+			if err != nil {
+				log.Printf("failed request: %v", err)
+				response.FailedWithError(c, apperrors.ClusterNotFoundInKarmada)
+				return
 			}
 		}
+		merger.clusters = append(merger.clusters, mergeCluster)
+	}
+
+	if dryRun {
+		response.Success(c, localizeMerge(c, merger.run()))
+		return
+	}
+	merger.verber, err = client.VerberClient(c.Request)
+	if err != nil {
+		log.Printf("failed request: %v", err)
+		response.FailedWithError(c, apperrors.FailedRequest)
+		return
 	}
-	response.Success(c, SyncResponse{TotalResource: TotalResource, FailResource: FailResource, SuccessResource: SuccessResource})
+	job := startMergeSyncJob(merger)
+	response.Accepted(c, job)
 }
@@ -0,0 +1,326 @@
+package sync
+
+import (
+	"log"
+	"sort"
+	gosync "sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/karmada-io/dashboard/cmd/api/app/monitoring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// States of a sync job.
+const (
+	SyncJobRunning   = "running"
+	SyncJobCompleted = "completed"
+	SyncJobCanceled  = "canceled"
+)
+
+// maxSyncJobs is the number of jobs kept in memory, the oldest finished jobs are removed first.
+const maxSyncJobs = 100
+
+var syncJobs = newSyncJobStore()
+
+// SyncJobProgress is the progress of the objects of one kind in one namespace.
+type SyncJobProgress struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Total     int    `json:"total"`
+	Done      int    `json:"done"`
+	Succeeded int    `json:"succeeded"`
+	Skipped   int    `json:"skipped"`
+	Failed    int    `json:"failed"`
+}
+
+// SyncJob is a sync running in the background, independent of the request that started it.
+type SyncJob struct {
+	ID        string `json:"id"`
+	ClusterId string `json:"clusterId,omitempty"`
+	// ClusterIds are the source clusters of a merge sync job
+	ClusterIds []string     `json:"clusterIds,omitempty"`
+	State      string       `json:"state"`
+	CreatedAt  metav1.Time  `json:"createdAt"`
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	Total      int          `json:"total"`
+	Done       int          `json:"done"`
+	// CancelRequested is set when the job is asked to stop, it stops before the next object
+	CancelRequested bool              `json:"cancelRequested"`
+	Progress        []SyncJobProgress `json:"progress"`
+	// ReportID is the id of the sync report, available once the job is finished
+	ReportID string `json:"reportId,omitempty"`
+	// MergeResult is the result of a merge sync job, available once the job is finished
+	MergeResult *MergeSyncResponse `json:"mergeResult,omitempty"`
+}
+
+type syncJobEntry struct {
+	job      SyncJob
+	progress map[string]*SyncJobProgress
+	canceled bool
+}
+
+type syncJobStore struct {
+	mu    gosync.Mutex
+	jobs  map[string]*syncJobEntry
+	order []string
+}
+
+func newSyncJobStore() *syncJobStore {
+	return &syncJobStore{jobs: make(map[string]*syncJobEntry)}
+}
+
+func newSyncJobEntry() *syncJobEntry {
+	return &syncJobEntry{
+		job: SyncJob{
+			ID:        string(uuid.NewUUID()),
+			State:     SyncJobRunning,
+			CreatedAt: metav1.Now(),
+		},
+		progress: make(map[string]*SyncJobProgress),
+	}
+}
+
+// create registers a running job for the objects of request, with kind names resolved by kinds.
+func (s *syncJobStore) create(clusterID string, request SyncRequest, kinds *SyncResourceKinds) SyncJob {
+	entry := newSyncJobEntry()
+	entry.job.ClusterId = clusterID
+	for range request.CreateNamespaces {
+		entry.progressOf("", namespaceKind.Kind).Total++
+		entry.job.Total++
+	}
+	for _, nsRes := range request.Data {
+		for _, kindGroup := range nsRes.List {
+			kind := resolvedKind(kinds, kindGroup.Kind)
+			entry.progressOf(nsRes.Namespace, kind).Total += len(kindGroup.List)
+			entry.job.Total += len(kindGroup.List)
+		}
+	}
+	return s.add(entry)
+}
+
+// createMerge registers a running job for the objects of a merge sync, with kind names resolved by kinds.
+// The total of a kind requested without names is added once the kind is listed.
+func (s *syncJobStore) createMerge(request MergeSyncRequest, kinds *SyncResourceKinds) SyncJob {
+	entry := newSyncJobEntry()
+	entry.job.ClusterIds = request.ClusterIds
+	for _, kindGroup := range request.List {
+		kind := resolvedKind(kinds, kindGroup.Kind)
+		entry.progressOf(request.Namespace, kind).Total += len(kindGroup.List)
+		entry.job.Total += len(kindGroup.List)
+	}
+	return s.add(entry)
+}
+
+func (s *syncJobStore) add(entry *syncJobEntry) SyncJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[entry.job.ID] = entry
+	s.order = append(s.order, entry.job.ID)
+	s.evict()
+	return entry.snapshot()
+}
+
+// resolvedKind returns the kind name of the resource served for kind, progress is keyed by it as a request may
+// use another name of the kind.
+func resolvedKind(kinds *SyncResourceKinds, kind string) string {
+	if res, ok := kinds.Lookup(kind); ok {
+		return res.Kind
+	}
+	return kind
+}
+
+// evict removes the oldest finished jobs beyond maxSyncJobs.
+func (s *syncJobStore) evict() {
+	for i := 0; len(s.jobs) > maxSyncJobs && i < len(s.order); {
+		id := s.order[i]
+		if s.jobs[id].job.State == SyncJobRunning {
+			i++
+			continue
+		}
+		delete(s.jobs, id)
+		s.order = append(s.order[:i], s.order[i+1:]...)
+	}
+}
+
+func (s *syncJobStore) get(id string) (SyncJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.jobs[id]
+	if !ok {
+		return SyncJob{}, false
+	}
+	return entry.snapshot(), true
+}
+
+// cancel asks a running job to stop before its next object.
+func (s *syncJobStore) cancel(id string) (SyncJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.jobs[id]
+	if !ok {
+		return SyncJob{}, false
+	}
+	if entry.job.State == SyncJobRunning {
+		entry.canceled = true
+		entry.job.CancelRequested = true
+	}
+	return entry.snapshot(), true
+}
+
+func (s *syncJobStore) isCanceled(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.jobs[id].canceled
+}
+
+// addTotal adds objects of a kind to the total of a running job.
+func (s *syncJobStore) addTotal(id string, namespace, kind string, total int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := s.jobs[id]
+	entry.progressOf(namespace, kind).Total += total
+	entry.job.Total += total
+}
+
+func (s *syncJobStore) record(id string, namespace, kind, outcome string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := s.jobs[id]
+	progress := entry.progressOf(namespace, kind)
+	progress.Done++
+	switch outcome {
+	case OutcomeFailed, OutcomeNamespaceMissing:
+		progress.Failed++
+	case OutcomeSkipped:
+		progress.Skipped++
+	default:
+		progress.Succeeded++
+	}
+	entry.job.Done++
+}
+
+func (s *syncJobStore) finish(id string, reportID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := s.jobs[id]
+	entry.finish()
+	entry.job.ReportID = reportID
+}
+
+// finishMerge finishes a merge sync job with its result.
+func (s *syncJobStore) finishMerge(id string, result *MergeSyncResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := s.jobs[id]
+	entry.finish()
+	entry.job.MergeResult = result
+}
+
+func (e *syncJobEntry) finish() {
+	e.job.State = SyncJobCompleted
+	if e.canceled {
+		e.job.State = SyncJobCanceled
+	}
+	now := metav1.Now()
+	e.job.FinishedAt = &now
+}
+
+func (e *syncJobEntry) progressOf(namespace, kind string) *SyncJobProgress {
+	key := namespace + "/" + kind
+	progress, ok := e.progress[key]
+	if !ok {
+		progress = &SyncJobProgress{Namespace: namespace, Kind: kind}
+		e.progress[key] = progress
+	}
+	return progress
+}
+
+// snapshot returns a copy of the job with the current progress.
+func (e *syncJobEntry) snapshot() SyncJob {
+	job := e.job
+	job.Progress = make([]SyncJobProgress, 0, len(e.progress))
+	for _, progress := range e.progress {
+		job.Progress = append(job.Progress, *progress)
+	}
+	sort.Slice(job.Progress, func(i, j int) bool {
+		if job.Progress[i].Namespace != job.Progress[j].Namespace {
+			return job.Progress[i].Namespace < job.Progress[j].Namespace
+		}
+		return job.Progress[i].Kind < job.Progress[j].Kind
+	})
+	return job
+}
+
+// localizeJob returns a copy of job with the reasons of its merge result translated to the language of the request.
+func localizeJob(c *gin.Context, job SyncJob) SyncJob {
+	if job.MergeResult != nil {
+		result := localizeMerge(c, *job.MergeResult)
+		job.MergeResult = &result
+	}
+	return job
+}
+
+// startSyncJob runs the sync of runner in the background and stores its report when it is finished.
+// The objects already processed when the job is canceled stay in Karmada and are part of the report.
+func startSyncJob(report *SyncReport, runner *syncRunner, policyGenerator *syncPolicyGenerator) SyncJob {
+	job := syncJobs.create(report.ClusterId, runner.request, runner.kinds)
+	report.JobID = job.ID
+	runner.canceled = func() bool {
+		return syncJobs.isCanceled(job.ID)
+	}
+	runner.onRecord = func(item SyncResultItem) {
+		syncJobs.record(job.ID, item.Namespace, resolvedKind(runner.kinds, item.Kind), item.Outcome)
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("sync job(%v) panic: %v", job.ID, r)
+				syncJobs.finish(job.ID, "")
+			}
+		}()
+
+		result := runner.run()
+		if policyGenerator != nil && !syncJobs.isCanceled(job.ID) {
+			report.Policies = policyGenerator.generate(runner.synced)
+		}
+		report.finish(result)
+		monitoring.AddSyncResources(report.SuccessResource, report.FailResource)
+		syncJobs.finish(job.ID, report.ID)
+		log.Printf("sync job(%v) finished: %d/%d resources succeeded", job.ID, report.SuccessResource, report.TotalResource)
+	}()
+	return job
+}
+
+// startMergeSyncJob runs the merge sync of merger in the background and stores its result in the job when it is
+// finished. The objects already merged when the job is canceled stay in Karmada and are part of the result.
+func startMergeSyncJob(merger *syncMerger) SyncJob {
+	kinds := merger.clusters[0].kinds
+	namespace := merger.request.Namespace
+	job := syncJobs.createMerge(merger.request, kinds)
+	merger.canceled = func() bool {
+		return syncJobs.isCanceled(job.ID)
+	}
+	merger.onList = func(kind string, total int) {
+		syncJobs.addTotal(job.ID, namespace, resolvedKind(kinds, kind), total)
+	}
+	merger.onRecord = func(item MergeSyncItem) {
+		syncJobs.record(job.ID, namespace, resolvedKind(kinds, item.Kind), item.Outcome)
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("merge sync job(%v) panic: %v", job.ID, r)
+				syncJobs.finishMerge(job.ID, nil)
+			}
+		}()
+
+		result := merger.run()
+		monitoring.AddSyncResources(result.SuccessResource, result.FailResource)
+		syncJobs.finishMerge(job.ID, &result)
+		log.Printf("merge sync job(%v) finished: %d/%d resources succeeded", job.ID, result.SuccessResource, result.TotalResource)
+	}()
+	return job
+}
@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestSyncJobStoreProgress(t *testing.T) {
+	store := newSyncJobStore()
+	kinds := &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind, "configmaps": configMapKind}}
+	request := SyncRequest{
+		CreateNamespaces: []string{"default"},
+		Data: []SyncRequestData{
+			{Namespace: "default", List: []SyncRequestResource{{Kind: "configmaps", List: []string{"web", "api", "cache"}}}},
+			{Namespace: "staging", List: []SyncRequestResource{{Kind: "configmap", List: []string{"web"}}}},
+		},
+	}
+	job := store.create("member1", request, kinds)
+	if job.State != SyncJobRunning || job.Total != 5 {
+		t.Fatalf("create() == %#v, expected a running job of 5 objects", job)
+	}
+
+	store.record(job.ID, "", namespaceKind.Kind, OutcomeSkipped)
+	store.record(job.ID, "default", "configmap", OutcomeCreated)
+	store.record(job.ID, "default", "configmap", OutcomeOverwritten)
+	store.record(job.ID, "default", "configmap", OutcomeFailed)
+	store.record(job.ID, "staging", "configmap", OutcomeNamespaceMissing)
+	store.finish(job.ID, "report-1")
+
+	job, _ = store.get(job.ID)
+	expected := []SyncJobProgress{
+		{Namespace: "", Kind: namespaceKind.Kind, Total: 1, Done: 1, Skipped: 1},
+		{Namespace: "default", Kind: "configmap", Total: 3, Done: 3, Succeeded: 2, Failed: 1},
+		{Namespace: "staging", Kind: "configmap", Total: 1, Done: 1, Failed: 1},
+	}
+	if !reflect.DeepEqual(job.Progress, expected) {
+		t.Errorf("get() progress == \n%#v\nexpected \n%#v\n", job.Progress, expected)
+	}
+	if job.State != SyncJobCompleted || job.Done != 5 || job.FinishedAt == nil || job.ReportID != "report-1" {
+		t.Errorf("get() == %#v, expected a completed job with report-1", job)
+	}
+}
+
+func TestSyncJobStoreCancel(t *testing.T) {
+	store := newSyncJobStore()
+	kinds := &SyncResourceKinds{}
+	running := store.create("member1", SyncRequest{}, kinds)
+	finished := store.create("member1", SyncRequest{}, kinds)
+	store.finish(finished.ID, "")
+
+	if job, ok := store.cancel(running.ID); !ok || !job.CancelRequested || !store.isCanceled(running.ID) {
+		t.Errorf("cancel(running) == %#v, %t expected a cancel request", job, ok)
+	}
+	store.finish(running.ID, "")
+	if job, _ := store.get(running.ID); job.State != SyncJobCanceled {
+		t.Errorf("finish(canceled) state == %s, expected %s", job.State, SyncJobCanceled)
+	}
+
+	// a finished job is returned as it is
+	if job, ok := store.cancel(finished.ID); !ok || job.CancelRequested || job.State != SyncJobCompleted {
+		t.Errorf("cancel(finished) == %#v, %t expected the completed job", job, ok)
+	}
+	if _, ok := store.cancel("missing"); ok {
+		t.Errorf("cancel(missing) found a job")
+	}
+	if _, ok := store.get("missing"); ok {
+		t.Errorf("get(missing) found a job")
+	}
+}
+
+func TestSyncJobStoreEvict(t *testing.T) {
+	store := newSyncJobStore()
+	kinds := &SyncResourceKinds{}
+	running := store.create("member1", SyncRequest{}, kinds)
+	var finished []string
+	for i := 1; i < maxSyncJobs; i++ {
+		job := store.create("member1", SyncRequest{}, kinds)
+		store.finish(job.ID, "")
+		finished = append(finished, job.ID)
+	}
+
+	// the oldest finished job is evicted, the running job older than it is kept
+	latest := store.create("member1", SyncRequest{}, kinds)
+	if len(store.jobs) != maxSyncJobs {
+		t.Errorf("create() kept %d jobs, expected %d", len(store.jobs), maxSyncJobs)
+	}
+	for _, id := range []string{running.ID, finished[1], latest.ID} {
+		if _, ok := store.get(id); !ok {
+			t.Errorf("get(%s) not found, expected the job kept", id)
+		}
+	}
+	if _, ok := store.get(finished[0]); ok {
+		t.Errorf("get(%s) found, expected the oldest finished job evicted", finished[0])
+	}
+}
+
+func TestStartSyncJobCancel(t *testing.T) {
+	scheme := runtime.NewScheme()
+	karmada := dynamicfake.NewSimpleDynamicClient(scheme, namespace("default"))
+	jobIDs := make(chan string, 1)
+	created := 0
+	// the job is canceled while its first object is created, it stops before the next object
+	karmada.PrependReactor("create", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		created++
+		if created == 1 {
+			syncJobs.cancel(<-jobIDs)
+		}
+		return false, nil, nil
+	})
+	filter, _ := newSyncFilter("", false, false)
+	runner := &syncRunner{
+		ctx:     context.Background(),
+		member:  dynamicfake.NewSimpleDynamicClient(scheme, configMap("web", nil), configMap("api", nil), configMap("cache", nil)),
+		karmada: karmada,
+		kinds:   &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind}},
+		filter:  filter,
+		request: SyncRequest{
+			Data: []SyncRequestData{{Namespace: "default", List: []SyncRequestResource{{Kind: "configmap", List: []string{"web", "api", "cache"}}}}},
+		},
+	}
+	report := newSyncReport("member1", runner.request)
+
+	job := startSyncJob(report, runner, nil)
+	jobIDs <- job.ID
+	err := wait.PollUntilContextTimeout(t.Context(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		job, _ = syncJobs.get(job.ID)
+		return job.State != SyncJobRunning, nil
+	})
+	if err != nil {
+		t.Fatalf("job %s is still running: %v", job.ID, err)
+	}
+
+	if job.State != SyncJobCanceled || job.Total != 3 || job.Done != 1 || job.ReportID != report.ID {
+		t.Errorf("job == %#v, expected a canceled job with 1 of 3 objects done", job)
+	}
+	stored, ok := syncReports.get("member1", report.ID)
+	if !ok || stored.JobID != job.ID || stored.TotalResource != 1 || stored.Items[0].Name != "web" {
+		t.Errorf("report == %#v, %t expected the report of the created object", stored, ok)
+	}
+}
@@ -0,0 +1,471 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/karmada-io/dashboard/cmd/api/app/localize"
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"github.com/karmada-io/dashboard/pkg/client"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/dynamic"
+)
+
+// MergeSyncRequest gathers the same namespace from several member clusters into one Karmada template per object.
+type MergeSyncRequest struct {
+	// ClusterIds are the source clusters, the template of an object is taken from the first cluster that has it
+	ClusterIds []string `json:"clusterIds"`
+	Namespace  string   `json:"namespace"`
+	// List holds the kinds and names to merge, every object of a kind in the namespace is merged if no name is given
+	List []SyncRequestResource `json:"list"`
+	// CreateNamespace creates the namespace in Karmada if it does not exist
+	CreateNamespace bool `json:"createNamespace"`
+	// ConflictStrategy is applied to objects that already exist in Karmada: skip or overwrite
+	ConflictStrategy string `json:"conflictStrategy"`
+	// CreatePropagationPolicy generates policies that propagate each object back to the clusters that have it
+	CreatePropagationPolicy bool `json:"createPropagationPolicy"`
+	// CreateOverridePolicy generates an OverridePolicy per object that keeps the differences of each cluster
+	CreateOverridePolicy  bool   `json:"createOverridePolicy"`
+	LabelSelector         string `json:"labelSelector"`
+	ExcludeOwned          bool   `json:"excludeOwned"`
+	IncludeKarmadaManaged bool   `json:"includeKarmadaManaged"`
+}
+
+// MergeConflict is a field with different values in the clusters.
+type MergeConflict struct {
+	Path string `json:"path"`
+	// Values are the values of the field by cluster id, for the base cluster and the clusters that differ from it
+	Values map[string]interface{} `json:"values"`
+}
+
+type MergeSyncItem struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Clusters are the ids of the clusters that have the object
+	Clusters []string `json:"clusters"`
+	// BaseClusterId is the cluster the template is taken from
+	BaseClusterId string `json:"baseClusterId,omitempty"`
+	// Outcome is the outcome of the sync, or the preview action for a dry run
+	Outcome          string          `json:"outcome"`
+	ConflictStrategy string          `json:"conflictStrategy,omitempty"`
+	Conflicts        []MergeConflict `json:"conflicts"`
+	Overrides        []MergeOverride `json:"overrides"`
+	// Manifest is the template, only returned for a dry run
+	Manifest map[string]interface{} `json:"manifest,omitempty"`
+	Reason   string                 `json:"reason,omitempty"`
+	Detail   string                 `json:"detail,omitempty"`
+}
+
+type MergeSyncResponse struct {
+	Namespace       string             `json:"namespace"`
+	ClusterIds      []string           `json:"clusterIds"`
+	TotalResource   int                `json:"totalResource"`
+	FailResource    int                `json:"failResource"`
+	SuccessResource int                `json:"successResource"`
+	SkipResource    int                `json:"skipResource"`
+	Items           []MergeSyncItem    `json:"items"`
+	Policies        []SyncPolicyResult `json:"policies,omitempty"`
+}
+
+func isValidMergeConflictStrategy(strategy string) bool {
+	// renamed objects would not be adopted in the clusters
+	return strategy != ConflictStrategyRename && isValidConflictStrategy(strategy)
+}
+
+// mergeCluster is a source cluster of a merge sync.
+type mergeCluster struct {
+	id string
+	// name is the name of the cluster in Karmada, only resolved when policies are generated
+	name   string
+	client dynamic.Interface
+	kinds  *SyncResourceKinds
+}
+
+// mergedObject is an object created or overwritten in Karmada by a merge sync.
+type mergedObject struct {
+	object       syncedObject
+	clusterNames []string
+	overrides    []MergeOverride
+}
+
+// syncMerger creates one Karmada object per object found in the namespace of several clusters.
+type syncMerger struct {
+	ctx      context.Context
+	clusters []mergeCluster
+	karmada  dynamic.Interface
+	// verber and policyClient are not set for a dry run
+	verber       client.ResourceVerber
+	policyClient karmadaclientset.Interface
+	filter       *syncFilter
+	request      MergeSyncRequest
+	dryRun       bool
+	// canceled is checked before each object, the merge stops once it returns true
+	canceled func() bool
+	// onList is called with the number of objects of a kind requested without names, once it is listed
+	onList func(kind string, total int)
+	// onRecord is called with the result of each object
+	onRecord func(item MergeSyncItem)
+
+	result MergeSyncResponse
+	merged []mergedObject
+}
+
+func (m *syncMerger) run() MergeSyncResponse {
+	m.result = MergeSyncResponse{
+		Namespace:  m.request.Namespace,
+		ClusterIds: m.request.ClusterIds,
+		Items:      make([]MergeSyncItem, 0),
+	}
+
+	if reason, err := m.ensureNamespace(); reason != "" {
+		log.Printf("merge sync fail: namespace(%v) is not available: %v", m.request.Namespace, err)
+		for _, kindGroup := range m.request.List {
+			for _, name := range kindGroup.List {
+				item := MergeSyncItem{Kind: kindGroup.Kind, Name: name, Outcome: OutcomeNamespaceMissing, Reason: reason}
+				if err != nil {
+					item.Detail = err.Error()
+				}
+				m.record(item)
+			}
+		}
+		return m.result
+	}
+
+	for _, kindGroup := range m.request.List {
+		if m.isCanceled() {
+			return m.result
+		}
+		m.mergeKind(kindGroup)
+	}
+	if !m.dryRun && !m.isCanceled() {
+		m.generatePolicies()
+	}
+	return m.result
+}
+
+func (m *syncMerger) isCanceled() bool {
+	if m.canceled == nil || !m.canceled() {
+		return false
+	}
+	log.Printf("merge sync canceled after %d resources", m.result.TotalResource)
+	return true
+}
+
+// ensureNamespace checks that the namespace exists in Karmada and creates it if requested,
+// from the namespace of the first cluster that has it. It returns the reason if the namespace is not available.
+func (m *syncMerger) ensureNamespace() (string, error) {
+	_, err := m.karmada.Resource(namespaceGVR).Get(m.ctx, m.request.Namespace, metav1.GetOptions{})
+	if err == nil {
+		return "", nil
+	}
+	if !errors.IsNotFound(err) {
+		return reasonOf(err), err
+	}
+	if !m.request.CreateNamespace {
+		return msgkey.NamespaceNotFound, err
+	}
+	if m.dryRun {
+		return "", nil
+	}
+
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName(m.request.Namespace)
+	for _, cluster := range m.clusters {
+		if obj, err := cluster.client.Resource(namespaceGVR).Get(m.ctx, m.request.Namespace, metav1.GetOptions{}); err == nil {
+			namespace = sanitizeObject(obj)
+			break
+		}
+	}
+	_, err = m.karmada.Resource(namespaceGVR).Create(m.ctx, namespace, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return reasonOf(err), err
+	}
+	return "", nil
+}
+
+// mergeKind merges the requested objects of a kind, or all of them if no name is requested.
+func (m *syncMerger) mergeKind(kindGroup SyncRequestResource) {
+	res, reason := m.lookupKind(kindGroup.Kind)
+	var err error
+	objects := make([]map[string]*unstructured.Unstructured, len(m.clusters))
+	for i := 0; reason == "" && i < len(m.clusters); i++ {
+		var list []unstructured.Unstructured
+		list, err = listK8sResources(res, m.request.Namespace, m.clusters[i].client)
+		if err != nil {
+			log.Printf("%v list fail(%v - %v) : %v", kindGroup.Kind, m.clusters[i].id, m.request.Namespace, err)
+			reason = reasonOf(err)
+			break
+		}
+		objects[i] = make(map[string]*unstructured.Unstructured, len(list))
+		for j := range list {
+			objects[i][list[j].GetName()] = &list[j]
+		}
+	}
+
+	names := kindGroup.List
+	if reason == "" && len(names) == 0 {
+		union := make(map[string]struct{})
+		for _, byName := range objects {
+			for name := range byName {
+				union[name] = struct{}{}
+			}
+		}
+		for name := range union {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if m.onList != nil {
+			m.onList(kindGroup.Kind, len(names))
+		}
+	}
+
+	for _, name := range names {
+		if m.isCanceled() {
+			return
+		}
+		if reason != "" {
+			item := MergeSyncItem{Kind: kindGroup.Kind, Name: name, Outcome: OutcomeFailed, Reason: reason}
+			if err != nil {
+				item.Detail = err.Error()
+			}
+			m.record(item)
+			continue
+		}
+		found := make([]*unstructured.Unstructured, len(m.clusters))
+		for i, byName := range objects {
+			found[i] = byName[name]
+		}
+		m.mergeObject(res, name, found)
+	}
+}
+
+// lookupKind returns the resource of kind, which must be namespaced and served with the same version by every cluster.
+func (m *syncMerger) lookupKind(kind string) (SyncResourceKind, string) {
+	res, ok := m.clusters[0].kinds.Lookup(kind)
+	if !ok || !res.Namespaced {
+		log.Printf("unsupported kind(%v)", kind)
+		return res, msgkey.UnsupportedResourceKind
+	}
+	for _, cluster := range m.clusters[1:] {
+		other, ok := cluster.kinds.Lookup(kind)
+		if !ok || other.GVR() != res.GVR() {
+			log.Printf("kind(%v) is not served by cluster(%v) with the same version", kind, cluster.id)
+			return res, msgkey.UnsupportedResourceKind
+		}
+	}
+	return res, ""
+}
+
+// mergeObject creates the template of an object from the copies found in the clusters, indexed like m.clusters.
+func (m *syncMerger) mergeObject(res SyncResourceKind, name string, found []*unstructured.Unstructured) {
+	item := MergeSyncItem{
+		Kind:      res.Kind,
+		Name:      name,
+		Clusters:  make([]string, 0),
+		Conflicts: make([]MergeConflict, 0),
+		Overrides: make([]MergeOverride, 0),
+		Outcome:   OutcomeFailed,
+	}
+	defer func() { m.record(item) }()
+
+	var present []int
+	sanitized := make([]*unstructured.Unstructured, len(found))
+	excluded := ""
+	for i, obj := range found {
+		if obj == nil {
+			continue
+		}
+		// a copy excluded by the filter is handled as if the cluster did not have the object
+		if reason := m.filter.excludeReason(obj); reason != "" {
+			excluded = reason
+			continue
+		}
+		present = append(present, i)
+		sanitized[i] = sanitizeObject(obj)
+		item.Clusters = append(item.Clusters, m.clusters[i].id)
+	}
+	if len(present) == 0 {
+		item.Reason = msgkey.SyncSourceNotFound
+		if excluded != "" {
+			item.Outcome = OutcomeSkipped
+			item.Reason = excluded
+		}
+		return
+	}
+
+	base := sanitized[present[0]]
+	item.BaseClusterId = m.clusters[present[0]].id
+	conflicts := make(map[string]*MergeConflict)
+	for _, i := range present[1:] {
+		diffs := diffObjects(base.Object, sanitized[i].Object)
+		if len(diffs) == 0 {
+			continue
+		}
+		for _, diff := range diffs {
+			conflict, ok := conflicts[diff.Path]
+			if !ok {
+				conflict = &MergeConflict{Path: diff.Path, Values: map[string]interface{}{item.BaseClusterId: diff.Karmada}}
+				conflicts[diff.Path] = conflict
+			}
+			conflict.Values[m.clusters[i].id] = diff.Member
+		}
+		plaintext, err := overridersFor(diffs)
+		if err != nil {
+			item.Reason = msgkey.ResourceOperationFailed
+			item.Detail = err.Error()
+			return
+		}
+		item.Overrides = append(item.Overrides, MergeOverride{
+			ClusterId:   m.clusters[i].id,
+			ClusterName: m.clusters[i].name,
+			Plaintext:   plaintext,
+		})
+	}
+	for _, conflict := range conflicts {
+		item.Conflicts = append(item.Conflicts, *conflict)
+	}
+	sort.Slice(item.Conflicts, func(i, j int) bool {
+		return item.Conflicts[i].Path < item.Conflicts[j].Path
+	})
+
+	if m.dryRun {
+		item.Manifest = base.Object
+		_, err := resourceClient(m.karmada, res, m.request.Namespace).Get(m.ctx, name, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			item.Outcome = PreviewActionExists
+		case errors.IsNotFound(err):
+			item.Outcome = PreviewActionCreate
+		default:
+			item.Reason = reasonOf(err)
+			item.Detail = err.Error()
+		}
+		return
+	}
+
+	_, err := resourceClient(m.karmada, res, m.request.Namespace).Create(m.ctx, base, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		item.Outcome = OutcomeCreated
+	case errors.IsAlreadyExists(err) && m.request.ConflictStrategy == ConflictStrategySkip:
+		item.ConflictStrategy = ConflictStrategySkip
+		item.Outcome = OutcomeSkipped
+		return
+	case errors.IsAlreadyExists(err) && m.request.ConflictStrategy == ConflictStrategyOverwrite:
+		item.ConflictStrategy = ConflictStrategyOverwrite
+		if err = m.verber.Update(base); err != nil {
+			log.Printf("%v overwrite fail(%v - %v) : %v", res.Kind, m.request.Namespace, name, err)
+			item.Reason = reasonOf(err)
+			item.Detail = err.Error()
+			return
+		}
+		item.Outcome = OutcomeOverwritten
+	default:
+		log.Printf("%v create fail(%v - %v) : %v", res.Kind, m.request.Namespace, name, err)
+		item.Reason = reasonOf(err)
+		item.Detail = err.Error()
+		return
+	}
+	log.Printf("%v %v(%v - %v) from %v", res.Kind, item.Outcome, m.request.Namespace, name, item.Clusters)
+
+	merged := mergedObject{
+		object: syncedObject{
+			Namespace:  m.request.Namespace,
+			APIVersion: base.GetAPIVersion(),
+			Kind:       base.GetKind(),
+			Name:       name,
+		},
+		overrides: item.Overrides,
+	}
+	for _, i := range present {
+		merged.clusterNames = append(merged.clusterNames, m.clusters[i].name)
+	}
+	m.merged = append(m.merged, merged)
+}
+
+// generatePolicies propagates each merged object to the clusters that had it, with one policy per set of clusters,
+// and keeps the differences of the clusters with an OverridePolicy per object.
+func (m *syncMerger) generatePolicies() {
+	if m.request.CreatePropagationPolicy {
+		var keys []string
+		groups := make(map[string][]mergedObject)
+		for _, merged := range m.merged {
+			key := strings.Join(merged.clusterNames, ",")
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], merged)
+		}
+		for _, key := range keys {
+			generator := &syncPolicyGenerator{
+				ctx:          m.ctx,
+				karmada:      m.policyClient,
+				name:         syncPolicyName(string(uuid.NewUUID())),
+				clusterNames: groups[key][0].clusterNames,
+			}
+			objects := make([]syncedObject, 0, len(groups[key]))
+			for _, merged := range groups[key] {
+				objects = append(objects, merged.object)
+			}
+			m.result.Policies = append(m.result.Policies, generator.generate(objects)...)
+		}
+	}
+
+	if m.request.CreateOverridePolicy {
+		generator := &syncOverrideGenerator{ctx: m.ctx, karmada: m.policyClient}
+		for _, merged := range m.merged {
+			if len(merged.overrides) > 0 {
+				m.result.Policies = append(m.result.Policies, generator.generate(merged.object, merged.overrides))
+			}
+		}
+	}
+}
+
+func (m *syncMerger) record(item MergeSyncItem) {
+	m.result.TotalResource++
+	switch item.Outcome {
+	case OutcomeFailed, OutcomeNamespaceMissing:
+		m.result.FailResource++
+	case OutcomeSkipped:
+		m.result.SkipResource++
+	default:
+		m.result.SuccessResource++
+	}
+	m.result.Items = append(m.result.Items, item)
+	if m.onRecord != nil {
+		m.onRecord(item)
+	}
+}
+
+// localizeMerge returns a copy of result with the reasons translated to the language of the request.
+func localizeMerge(c *gin.Context, result MergeSyncResponse) MergeSyncResponse {
+	items := make([]MergeSyncItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		if item.Reason != "" {
+			item.Reason = localize.GetLocalizeMessage(c, item.Reason)
+		}
+		items = append(items, item)
+	}
+	result.Items = items
+
+	if len(result.Policies) > 0 {
+		policies := make([]SyncPolicyResult, 0, len(result.Policies))
+		for _, policy := range result.Policies {
+			if policy.Reason != "" {
+				policy.Reason = localize.GetLocalizeMessage(c, policy.Reason)
+			}
+			policies = append(policies, policy)
+		}
+		result.Policies = policies
+	}
+	return result
+}
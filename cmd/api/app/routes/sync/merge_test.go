@@ -0,0 +1,197 @@
+package sync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var deploymentKind = SyncResourceKind{Kind: "deployment", Group: "apps", Version: "v1", Resource: "deployments", Namespaced: true}
+
+func deployment(name string, replicas int64, image, env string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{
+						"name":  "web",
+						"image": image,
+						"env":   []interface{}{map[string]interface{}{"name": "MODE", "value": env}},
+					}},
+				},
+			},
+		},
+	}}
+}
+
+func fakeDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		namespaceGVR:         "NamespaceList",
+		deploymentKind.GVR(): "DeploymentList",
+	}, objects...)
+}
+
+// newTestMerger returns a merger of the deployments of three clusters, the clusters without an object are nil.
+func newTestMerger(t *testing.T, karmada *dynamicfake.FakeDynamicClient, request MergeSyncRequest, clusters ...[]runtime.Object) *syncMerger {
+	filter, _ := newSyncFilter("", false, false)
+	merger := &syncMerger{
+		ctx:     t.Context(),
+		karmada: karmada,
+		verber:  &updateRecorder{},
+		filter:  filter,
+		request: request,
+	}
+	kinds := &SyncResourceKinds{kinds: map[string]SyncResourceKind{"deployment": deploymentKind}}
+	for i, objects := range clusters {
+		merger.clusters = append(merger.clusters, mergeCluster{
+			id:     []string{"member1", "member2", "member3"}[i],
+			client: fakeDynamicClient(objects...),
+			kinds:  kinds,
+		})
+	}
+	return merger
+}
+
+func TestSyncMergerConflicts(t *testing.T) {
+	karmada := fakeDynamicClient(namespace("default"))
+	request := MergeSyncRequest{
+		ClusterIds: []string{"member1", "member2", "member3"},
+		Namespace:  "default",
+		List:       []SyncRequestResource{{Kind: "deployment"}},
+	}
+	merger := newTestMerger(t, karmada, request,
+		[]runtime.Object{deployment("web", 2, "nginx:1.25", "blue")},
+		[]runtime.Object{deployment("web", 3, "nginx:1.25", "blue"), deployment("api", 1, "api:2.0", "blue")},
+		[]runtime.Object{deployment("web", 2, "nginx:1.26", "green"), deployment("api", 1, "api:2.0", "blue")},
+	)
+
+	result := merger.run()
+	if result.TotalResource != 2 || result.SuccessResource != 2 {
+		t.Fatalf("run() == %#v, expected 2 objects created", result)
+	}
+
+	// api is identical in member2 and member3, the first cluster that has it is the base
+	api := result.Items[0]
+	if api.Name != "api" || api.BaseClusterId != "member2" || !reflect.DeepEqual(api.Clusters, []string{"member2", "member3"}) ||
+		len(api.Conflicts) != 0 || len(api.Overrides) != 0 || api.Outcome != OutcomeCreated {
+		t.Errorf("run() api == %#v, expected a created object from member2 without conflicts", api)
+	}
+
+	web := result.Items[1]
+	expectedConflicts := []MergeConflict{
+		{Path: "spec.replicas", Values: map[string]interface{}{"member1": int64(2), "member2": int64(3)}},
+		{Path: "spec.template.spec.containers[0].env[0].value", Values: map[string]interface{}{"member1": "blue", "member3": "green"}},
+		{Path: "spec.template.spec.containers[0].image", Values: map[string]interface{}{"member1": "nginx:1.25", "member3": "nginx:1.26"}},
+	}
+	if web.BaseClusterId != "member1" || web.Outcome != OutcomeCreated || !reflect.DeepEqual(web.Conflicts, expectedConflicts) {
+		t.Errorf("run() web conflicts == \n%#v\nexpected \n%#v\n", web.Conflicts, expectedConflicts)
+	}
+	overridden := make(map[string]int)
+	for _, override := range web.Overrides {
+		overridden[override.ClusterId] = len(override.Plaintext)
+	}
+	if !reflect.DeepEqual(overridden, map[string]int{"member2": 1, "member3": 2}) {
+		t.Errorf("run() web overrides == %v, expected 1 for member2 and 2 for member3", overridden)
+	}
+
+	// the template is the copy of the base cluster
+	template, err := karmada.Resource(deploymentKind.GVR()).Namespace("default").Get(t.Context(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get(web) failed: %v", err)
+	}
+	replicas, _, _ := unstructured.NestedInt64(template.Object, "spec", "replicas")
+	containers, _, _ := unstructured.NestedSlice(template.Object, "spec", "template", "spec", "containers")
+	if replicas != 2 || containers[0].(map[string]interface{})["image"] != "nginx:1.25" {
+		t.Errorf("template web == %#v, expected the member1 copy", template.Object)
+	}
+}
+
+func TestSyncMergerConflictStrategy(t *testing.T) {
+	cases := []struct {
+		strategy string
+		dryRun   bool
+		outcome  string
+		updated  []string
+	}{
+		{"", false, OutcomeFailed, nil},
+		{ConflictStrategySkip, false, OutcomeSkipped, nil},
+		{ConflictStrategyOverwrite, false, OutcomeOverwritten, []string{"web"}},
+		{ConflictStrategyOverwrite, true, PreviewActionExists, nil},
+	}
+	for _, c := range cases {
+		karmada := fakeDynamicClient(namespace("default"), deployment("web", 5, "nginx:1.24", "blue"))
+		request := MergeSyncRequest{
+			ClusterIds:       []string{"member1", "member2"},
+			Namespace:        "default",
+			List:             []SyncRequestResource{{Kind: "deployment", List: []string{"web"}}},
+			ConflictStrategy: c.strategy,
+		}
+		merger := newTestMerger(t, karmada, request,
+			[]runtime.Object{deployment("web", 2, "nginx:1.25", "blue")},
+			[]runtime.Object{deployment("web", 2, "nginx:1.25", "blue")},
+		)
+		merger.dryRun = c.dryRun
+
+		result := merger.run()
+		if len(result.Items) != 1 || result.Items[0].Outcome != c.outcome {
+			t.Fatalf("run(%q, dryRun=%t) == %#v, expected %s", c.strategy, c.dryRun, result.Items, c.outcome)
+		}
+		if updated := merger.verber.(*updateRecorder).updated; !reflect.DeepEqual(updated, c.updated) {
+			t.Errorf("run(%q, dryRun=%t) updated %v, expected %v", c.strategy, c.dryRun, updated, c.updated)
+		}
+		if c.dryRun && result.Items[0].Manifest == nil {
+			t.Errorf("run(%q, dryRun=%t) has no manifest", c.strategy, c.dryRun)
+		}
+		// the existing object is only overwritten through the verber
+		template, _ := karmada.Resource(deploymentKind.GVR()).Namespace("default").Get(t.Context(), "web", metav1.GetOptions{})
+		if replicas, _, _ := unstructured.NestedInt64(template.Object, "spec", "replicas"); replicas != 5 {
+			t.Errorf("run(%q, dryRun=%t) karmada replicas == %d, expected 5", c.strategy, c.dryRun, replicas)
+		}
+	}
+}
+
+func TestStartMergeSyncJob(t *testing.T) {
+	request := MergeSyncRequest{
+		ClusterIds: []string{"member1", "member2"},
+		Namespace:  "default",
+		List:       []SyncRequestResource{{Kind: "deployment"}},
+	}
+	merger := newTestMerger(t, fakeDynamicClient(namespace("default")), request,
+		[]runtime.Object{deployment("web", 2, "nginx:1.25", "blue")},
+		[]runtime.Object{deployment("web", 2, "nginx:1.25", "blue"), deployment("api", 1, "api:2.0", "blue")},
+	)
+	merger.ctx = context.Background()
+
+	job := startMergeSyncJob(merger)
+	if !reflect.DeepEqual(job.ClusterIds, request.ClusterIds) || job.Total != 0 {
+		t.Errorf("startMergeSyncJob() == %#v, expected a job of the clusters with an unknown total", job)
+	}
+	err := wait.PollUntilContextTimeout(t.Context(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		job, _ = syncJobs.get(job.ID)
+		return job.State != SyncJobRunning, nil
+	})
+	if err != nil {
+		t.Fatalf("job %s is still running: %v", job.ID, err)
+	}
+
+	// the total of the kind is added once it is listed
+	expected := []SyncJobProgress{{Namespace: "default", Kind: "deployment", Total: 2, Done: 2, Succeeded: 2}}
+	if job.State != SyncJobCompleted || !reflect.DeepEqual(job.Progress, expected) {
+		t.Errorf("job == %#v, expected a completed job with progress %#v", job, expected)
+	}
+	if job.MergeResult == nil || job.MergeResult.SuccessResource != 2 {
+		t.Errorf("job merge result == %#v, expected 2 created objects", job.MergeResult)
+	}
+}
@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strings"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+)
+
+// MergeOverride holds the overriders that turn the merged template into the object of a cluster.
+type MergeOverride struct {
+	ClusterId   string                              `json:"clusterId"`
+	ClusterName string                              `json:"clusterName,omitempty"`
+	Plaintext   []policyv1alpha1.PlaintextOverrider `json:"plaintext"`
+}
+
+// overridePolicyName returns the name of the OverridePolicy of an object. A name longer than a DNS subdomain is
+// truncated and suffixed with a hash of the full name, so that it stays unique.
+func overridePolicyName(kind, name string) string {
+	policyName := syncPolicyPrefix + strings.ToLower(kind) + "-" + name
+	if len(policyName) <= validation.DNS1123SubdomainMaxLength {
+		return policyName
+	}
+	hasher := fnv.New32a()
+	hasher.Write([]byte(policyName))
+	suffix := rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+	truncated := strings.TrimRight(policyName[:validation.DNS1123SubdomainMaxLength-len(suffix)-1], "-.")
+	return truncated + "-" + suffix
+}
+
+// overridersFor converts the differences between the template (Karmada side) and the object of a cluster
+// (member side) into plaintext overriders applied to the template.
+func overridersFor(diffs []FieldDiff) ([]policyv1alpha1.PlaintextOverrider, error) {
+	overriders := make([]policyv1alpha1.PlaintextOverrider, 0, len(diffs))
+	for _, diff := range diffs {
+		overrider := policyv1alpha1.PlaintextOverrider{Path: diff.pointer, Operator: policyv1alpha1.OverriderOpReplace}
+		switch {
+		case diff.Member == nil:
+			overrider.Operator = policyv1alpha1.OverriderOpRemove
+			overriders = append(overriders, overrider)
+			continue
+		case diff.Karmada == nil:
+			overrider.Operator = policyv1alpha1.OverriderOpAdd
+		}
+		value, err := json.Marshal(diff.Member)
+		if err != nil {
+			return nil, err
+		}
+		overrider.Value = apiextensionsv1.JSON{Raw: value}
+		overriders = append(overriders, overrider)
+	}
+	return overriders, nil
+}
+
+// syncOverrideGenerator generates an OverridePolicy per merged object that keeps the differences of each cluster.
+type syncOverrideGenerator struct {
+	ctx     context.Context
+	karmada karmadaclientset.Interface
+}
+
+// generate creates or replaces the OverridePolicy of the object. The overrides must have their cluster name set.
+func (g *syncOverrideGenerator) generate(obj syncedObject, overrides []MergeOverride) SyncPolicyResult {
+	result := SyncPolicyResult{
+		Kind:      "OverridePolicy",
+		Namespace: obj.Namespace,
+		Name:      overridePolicyName(obj.Kind, obj.Name),
+		Selectors: 1,
+	}
+	spec := policyv1alpha1.OverrideSpec{
+		ResourceSelectors: []policyv1alpha1.ResourceSelector{{
+			APIVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+			Namespace:  obj.Namespace,
+			Name:       obj.Name,
+		}},
+	}
+	for _, override := range overrides {
+		spec.OverrideRules = append(spec.OverrideRules, policyv1alpha1.RuleWithCluster{
+			TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{override.ClusterName}},
+			Overriders:    policyv1alpha1.Overriders{Plaintext: override.Plaintext},
+		})
+	}
+
+	client := g.karmada.PolicyV1alpha1().OverridePolicies(obj.Namespace)
+	result.Outcome = PolicyOutcomeUpdated
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		policy, err := client.Get(g.ctx, result.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			result.Outcome = PolicyOutcomeCreated
+			policy = &policyv1alpha1.OverridePolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: obj.Namespace, Name: result.Name},
+				Spec:       spec,
+			}
+			_, err = client.Create(g.ctx, policy, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		// the rules are generated from the current state of the clusters and replace the previous ones
+		policy.Spec = spec
+		_, err = client.Update(g.ctx, policy, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		log.Printf("sync override policy create fail(%v - %v) : %v", obj.Namespace, result.Name, err)
+		result.Outcome = PolicyOutcomeFailed
+		result.Reason = reasonOf(err)
+		result.Detail = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestOverridersFor(t *testing.T) {
+	template := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "web", "tier": "front"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "nginx:1.25"},
+					},
+				},
+			},
+		},
+	}
+	cluster := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "web-legacy"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"paused":   true,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "nginx:1.21"},
+					},
+				},
+			},
+		},
+	}
+	expected := []policyv1alpha1.PlaintextOverrider{
+		{Path: "/metadata/labels/app.kubernetes.io~1name", Operator: policyv1alpha1.OverriderOpReplace, Value: apiextensionsv1.JSON{Raw: []byte(`"web-legacy"`)}},
+		{Path: "/metadata/labels/tier", Operator: policyv1alpha1.OverriderOpRemove},
+		{Path: "/spec/paused", Operator: policyv1alpha1.OverriderOpAdd, Value: apiextensionsv1.JSON{Raw: []byte(`true`)}},
+		{Path: "/spec/replicas", Operator: policyv1alpha1.OverriderOpReplace, Value: apiextensionsv1.JSON{Raw: []byte(`3`)}},
+		{Path: "/spec/template/spec/containers/0/image", Operator: policyv1alpha1.OverriderOpReplace, Value: apiextensionsv1.JSON{Raw: []byte(`"nginx:1.21"`)}},
+	}
+
+	actual, err := overridersFor(diffObjects(template, cluster))
+	if err != nil {
+		t.Fatalf("overridersFor() failed: %v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("overridersFor() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+}
+
+func TestOverridePolicyName(t *testing.T) {
+	if actual := overridePolicyName("Deployment", "web"); actual != "sync-deployment-web" {
+		t.Errorf("overridePolicyName(web) == %s, expected sync-deployment-web", actual)
+	}
+
+	long := strings.Repeat("a", 253)
+	other := strings.Repeat("a", 252) + "b"
+	actual := overridePolicyName("Deployment", long)
+	if errs := validation.IsDNS1123Subdomain(actual); len(errs) > 0 || !strings.HasPrefix(actual, "sync-deployment-aaa") {
+		t.Errorf("overridePolicyName(long) == %s (%d), expected a truncated valid name: %v", actual, len(actual), errs)
+	}
+	if actual == overridePolicyName("Deployment", other) {
+		t.Errorf("overridePolicyName() == %s for two long names, expected different hash suffixes", actual)
+	}
+}
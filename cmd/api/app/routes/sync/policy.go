@@ -0,0 +1,186 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sort"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// syncPolicyPrefix is the name prefix of the policies generated for synced objects,
+// followed by the id of the sync that generated them.
+const syncPolicyPrefix = "sync-"
+
+// syncPolicyName returns the name of the policies generated by the sync with the given id. Each sync generates its
+// own policies, so that their selectors do not pile up across syncs to the same clusters.
+func syncPolicyName(syncID string) string {
+	return syncPolicyPrefix + syncID
+}
+
+// Outcomes of a generated policy.
+const (
+	PolicyOutcomeCreated = "created"
+	PolicyOutcomeUpdated = "updated"
+	PolicyOutcomeFailed  = "failed"
+)
+
+// SyncPolicyResult is a PropagationPolicy, ClusterPropagationPolicy or OverridePolicy generated for synced objects.
+type SyncPolicyResult struct {
+	Kind           string `json:"kind"`
+	Namespace      string `json:"namespace,omitempty"`
+	Name           string `json:"name"`
+	IsClusterScope bool   `json:"isClusterScope"`
+	Selectors      int    `json:"selectors"`
+	Outcome        string `json:"outcome"`
+	Reason         string `json:"reason,omitempty"`
+	Detail         string `json:"detail,omitempty"`
+}
+
+// syncedObject is an object created or overwritten in Karmada by a sync.
+type syncedObject struct {
+	Namespace  string
+	APIVersion string
+	Kind       string
+	Name       string
+}
+
+// syncPolicyGenerator generates policies that propagate synced objects back to their source clusters.
+// The policies resolve conflicts by overwriting, so the original objects are adopted in place.
+type syncPolicyGenerator struct {
+	ctx     context.Context
+	karmada karmadaclientset.Interface
+	// name is the name of the generated policies, see syncPolicyName
+	name         string
+	clusterNames []string
+}
+
+// generate creates or extends one PropagationPolicy per namespace and one ClusterPropagationPolicy
+// for the cluster-scoped objects.
+func (g *syncPolicyGenerator) generate(objects []syncedObject) []SyncPolicyResult {
+	selectors := make(map[string][]policyv1alpha1.ResourceSelector)
+	for _, obj := range objects {
+		selectors[obj.Namespace] = append(selectors[obj.Namespace], policyv1alpha1.ResourceSelector{
+			APIVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+			Namespace:  obj.Namespace,
+			Name:       obj.Name,
+		})
+	}
+	namespaces := make([]string, 0, len(selectors))
+	for namespace := range selectors {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	results := make([]SyncPolicyResult, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		result := SyncPolicyResult{
+			Namespace:      namespace,
+			Name:           g.name,
+			IsClusterScope: namespace == "",
+		}
+		var err error
+		if result.IsClusterScope {
+			result.Kind = "ClusterPropagationPolicy"
+			result.Outcome, result.Selectors, err = g.applyClusterPropagationPolicy(result.Name, selectors[namespace])
+		} else {
+			result.Kind = "PropagationPolicy"
+			result.Outcome, result.Selectors, err = g.applyPropagationPolicy(namespace, result.Name, selectors[namespace])
+		}
+		if err != nil {
+			log.Printf("sync policy create fail(%v - %v) : %v", namespace, result.Name, err)
+			result.Outcome = PolicyOutcomeFailed
+			result.Reason = reasonOf(err)
+			result.Detail = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (g *syncPolicyGenerator) applyPropagationPolicy(namespace, name string, selectors []policyv1alpha1.ResourceSelector) (string, int, error) {
+	client := g.karmada.PolicyV1alpha1().PropagationPolicies(namespace)
+	outcome := PolicyOutcomeUpdated
+	var count int
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		policy, err := client.Get(g.ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			outcome = PolicyOutcomeCreated
+			policy = &policyv1alpha1.PropagationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			}
+			g.mergeSpec(&policy.Spec, selectors)
+			count = len(policy.Spec.ResourceSelectors)
+			_, err = client.Create(g.ctx, policy, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		g.mergeSpec(&policy.Spec, selectors)
+		count = len(policy.Spec.ResourceSelectors)
+		_, err = client.Update(g.ctx, policy, metav1.UpdateOptions{})
+		return err
+	})
+	return outcome, count, err
+}
+
+func (g *syncPolicyGenerator) applyClusterPropagationPolicy(name string, selectors []policyv1alpha1.ResourceSelector) (string, int, error) {
+	client := g.karmada.PolicyV1alpha1().ClusterPropagationPolicies()
+	outcome := PolicyOutcomeUpdated
+	var count int
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		policy, err := client.Get(g.ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			outcome = PolicyOutcomeCreated
+			policy = &policyv1alpha1.ClusterPropagationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+			}
+			g.mergeSpec(&policy.Spec, selectors)
+			count = len(policy.Spec.ResourceSelectors)
+			_, err = client.Create(g.ctx, policy, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		g.mergeSpec(&policy.Spec, selectors)
+		count = len(policy.Spec.ResourceSelectors)
+		_, err = client.Update(g.ctx, policy, metav1.UpdateOptions{})
+		return err
+	})
+	return outcome, count, err
+}
+
+// mergeSpec adds the selectors missing from spec and makes it target the source clusters with overwriting conflict resolution.
+func (g *syncPolicyGenerator) mergeSpec(spec *policyv1alpha1.PropagationSpec, selectors []policyv1alpha1.ResourceSelector) {
+	for _, selector := range selectors {
+		if !containsSelector(spec.ResourceSelectors, selector) {
+			spec.ResourceSelectors = append(spec.ResourceSelectors, selector)
+		}
+	}
+	if spec.Placement.ClusterAffinity == nil {
+		spec.Placement.ClusterAffinity = &policyv1alpha1.ClusterAffinity{}
+	}
+	for _, clusterName := range g.clusterNames {
+		if !slices.Contains(spec.Placement.ClusterAffinity.ClusterNames, clusterName) {
+			spec.Placement.ClusterAffinity.ClusterNames = append(spec.Placement.ClusterAffinity.ClusterNames, clusterName)
+		}
+	}
+	spec.ConflictResolution = policyv1alpha1.ConflictOverwrite
+}
+
+func containsSelector(selectors []policyv1alpha1.ResourceSelector, selector policyv1alpha1.ResourceSelector) bool {
+	for _, s := range selectors {
+		if s.APIVersion == selector.APIVersion && s.Kind == selector.Kind && s.Namespace == selector.Namespace && s.Name == selector.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestSyncPolicyGenerator(t *testing.T) {
+	karmada := karmadafake.NewSimpleClientset()
+	web := syncedObject{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "web"}
+	api := syncedObject{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "api"}
+
+	// two syncs to the same cluster generate a policy each
+	for _, run := range []struct {
+		id      string
+		objects []syncedObject
+	}{
+		{"first", []syncedObject{web, web}},
+		{"second", []syncedObject{api}},
+	} {
+		generator := &syncPolicyGenerator{ctx: t.Context(), karmada: karmada, name: syncPolicyName(run.id), clusterNames: []string{"member1"}}
+		results := generator.generate(run.objects)
+		if len(results) != 1 || results[0].Name != "sync-"+run.id || results[0].Outcome != PolicyOutcomeCreated || results[0].Selectors != 1 {
+			t.Errorf("generate(%s) == %#v, expected a created policy sync-%s with 1 selector", run.id, results, run.id)
+		}
+	}
+
+	policy, err := karmada.PolicyV1alpha1().PropagationPolicies("default").Get(t.Context(), "sync-first", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get(sync-first) failed: %v", err)
+	}
+	if len(policy.Spec.ResourceSelectors) != 1 || policy.Spec.ResourceSelectors[0].Name != "web" ||
+		!reflect.DeepEqual(policy.Spec.Placement.ClusterAffinity.ClusterNames, []string{"member1"}) {
+		t.Errorf("sync-first spec == %#v, expected only the web selector targeting member1", policy.Spec)
+	}
+}
+
+func TestSyncRunnerRenameIsNotPropagated(t *testing.T) {
+	scheme := runtime.NewScheme()
+	filter, _ := newSyncFilter("", false, false)
+	karmada := dynamicfake.NewSimpleDynamicClient(scheme, namespace("default"), configMap("web", nil))
+	runner := &syncRunner{
+		ctx:     t.Context(),
+		member:  dynamicfake.NewSimpleDynamicClient(scheme, configMap("web", nil), configMap("api", nil)),
+		karmada: karmada,
+		kinds:   &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind}},
+		filter:  filter,
+		request: SyncRequest{
+			Data:             []SyncRequestData{{Namespace: "default", List: []SyncRequestResource{{Kind: "configmap", List: []string{"web", "api"}}}}},
+			ConflictStrategy: ConflictStrategyRename,
+		},
+	}
+
+	result := runner.run()
+	if result.SuccessResource != 2 || result.Items[0].TargetName != "web-synced" {
+		t.Errorf("run() == %#v, expected web renamed to web-synced and api created", result.Items)
+	}
+	expected := []syncedObject{{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "api"}}
+	if !reflect.DeepEqual(runner.synced, expected) {
+		t.Errorf("run() synced %#v, expected only the objects created under their own name %#v", runner.synced, expected)
+	}
+}
@@ -0,0 +1,265 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+)
+
+// Actions reported by a sync preview.
+const (
+	// PreviewActionCreate means the object does not exist in Karmada and would be created.
+	PreviewActionCreate = "create"
+	// PreviewActionExists means the object already exists in Karmada and would fail without a conflict strategy,
+	// the diff shows how it differs.
+	PreviewActionExists = "exists"
+	// PreviewActionOverwrite means the existing Karmada object would be overwritten, the diff shows the changes.
+	PreviewActionOverwrite = "overwrite"
+	// PreviewActionRename means the object already exists in Karmada and would be created under the target name.
+	PreviewActionRename = "rename"
+	// PreviewActionFailed means the object could not be synced, the reason tells why.
+	PreviewActionFailed = "failed"
+	// PreviewActionSkipped means the object is excluded by the filters of the request or already exists in Karmada
+	// with the skip strategy, the reason tells why.
+	PreviewActionSkipped = "skipped"
+)
+
+// namespaceKind is the namespace resource, which is always syncable through CreateNamespaces.
+var namespaceKind = SyncResourceKind{Kind: "namespace", Version: namespaceGVR.Version, Resource: namespaceGVR.Resource}
+
+type SyncPreviewItem struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	// TargetName is the name the object would be created under if it is renamed
+	TargetName string `json:"targetName,omitempty"`
+	Action     string `json:"action"`
+	// Manifest is the sanitized object that would be sent to Karmada
+	Manifest map[string]interface{} `json:"manifest,omitempty"`
+	Diff     []FieldDiff            `json:"diff,omitempty"`
+	// ServerValidated is true if Karmada accepted the object in a dry-run create or update.
+	// Objects in a namespace that would only be created by the same sync cannot be validated.
+	ServerValidated bool `json:"serverValidated"`
+	// Reason is the localized reason of a failed or skipped object
+	Reason string `json:"reason,omitempty"`
+	// Detail is the error returned by Kubernetes
+	Detail string `json:"detail,omitempty"`
+}
+
+type SyncPreviewResponse struct {
+	Items []SyncPreviewItem `json:"items"`
+	// Dependencies are the objects added to the sync by includeDependencies
+	Dependencies []SyncDependency `json:"dependencies,omitempty"`
+}
+
+// syncPreviewer previews a sync request with server-side dry-run calls to Karmada.
+type syncPreviewer struct {
+	ctx     context.Context
+	member  dynamic.Interface
+	karmada dynamic.Interface
+	// verber overwrites objects in Karmada with dry-run updates, like the runner does with its verber
+	verber client.ResourceVerber
+	kinds  *SyncResourceKinds
+	filter *syncFilter
+	// localize translates message keys to the language of the request
+	localize func(key string) string
+
+	request SyncRequest
+}
+
+func (p *syncPreviewer) preview(request SyncRequest) SyncPreviewResponse {
+	p.request = request
+	items := make([]SyncPreviewItem, 0)
+
+	// namespaces created by this sync, their resources cannot be validated by the server yet
+	pendingNamespaces := sets.New[string]()
+	for _, ns := range request.CreateNamespaces {
+		item := p.previewObject(namespaceKind, "", ns, true)
+		if item.Action == PreviewActionCreate {
+			pendingNamespaces.Insert(ns)
+		}
+		items = append(items, item)
+	}
+
+	for _, nsRes := range request.Data {
+		namespaceReady := !pendingNamespaces.Has(nsRes.Namespace)
+		if nsRes.Namespace != "" && namespaceReady {
+			_, err := p.karmada.Resource(namespaceGVR).Get(p.ctx, nsRes.Namespace, metav1.GetOptions{})
+			if err != nil {
+				reason := reasonOf(err)
+				if errors.IsNotFound(err) {
+					reason = msgkey.NamespaceNotFound
+				}
+				items = append(items, p.failedGroup(nsRes, reason, err)...)
+				continue
+			}
+		}
+
+		for _, kindGroup := range nsRes.List {
+			res, ok := p.kinds.Lookup(kindGroup.Kind)
+			for _, name := range kindGroup.List {
+				switch {
+				case !ok:
+					items = append(items, p.failedItem(nsRes.Namespace, kindGroup.Kind, name, msgkey.UnsupportedResourceKind, nil))
+				case res.Namespaced && nsRes.Namespace == "":
+					items = append(items, p.failedItem(nsRes.Namespace, kindGroup.Kind, name, msgkey.ResourceNamespaceRequired, nil))
+				default:
+					items = append(items, p.previewObject(res, nsRes.Namespace, name, namespaceReady))
+				}
+			}
+		}
+	}
+	return SyncPreviewResponse{Items: items}
+}
+
+// previewObject sanitizes the member object and compares it with Karmada.
+// If the object is absent, it is validated with a server-side dry-run create when validate is true.
+// If it exists, the conflict strategy of the request is previewed as the runner would apply it.
+func (p *syncPreviewer) previewObject(res SyncResourceKind, namespace, name string, validate bool) SyncPreviewItem {
+	srcObj, err := resourceClient(p.member, res, namespace).Get(p.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		reason := reasonOf(err)
+		if errors.IsNotFound(err) {
+			reason = msgkey.SyncSourceNotFound
+		}
+		return p.failedItem(namespace, res.Kind, name, reason, err)
+	}
+	// namespaces and dependencies are created for the requested objects and not filtered
+	if res.GVR() != namespaceGVR && !p.filter.isDependency(namespace, res.Kind, name) {
+		if reason := p.filter.excludeReason(srcObj); reason != "" {
+			return p.skippedItem(namespace, res.Kind, name, reason)
+		}
+	}
+	objCopy := sanitizeObject(srcObj)
+	item := SyncPreviewItem{
+		Namespace: namespace,
+		Kind:      res.Kind,
+		Name:      name,
+		Action:    PreviewActionCreate,
+		Manifest:  objCopy.Object,
+	}
+
+	existing, err := resourceClient(p.karmada, res, namespace).Get(p.ctx, name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		return p.previewConflict(res, item, existing, objCopy, validate)
+	case !errors.IsNotFound(err):
+		return p.failedItem(namespace, res.Kind, name, reasonOf(err), err)
+	}
+
+	if !validate {
+		return item
+	}
+	_, err = resourceClient(p.karmada, res, namespace).Create(p.ctx, objCopy, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return p.failedItem(namespace, res.Kind, name, reasonOf(err), err)
+	}
+	item.ServerValidated = true
+	return item
+}
+
+// previewConflict previews the conflict strategy of the request for obj, which already exists in Karmada.
+func (p *syncPreviewer) previewConflict(res SyncResourceKind, item SyncPreviewItem, existing, obj *unstructured.Unstructured, validate bool) SyncPreviewItem {
+	item.Diff = diffObjects(sanitizeObject(existing).Object, obj.Object)
+	strategy := p.request.ConflictStrategy
+	// namespaces cannot be renamed, the runner skips them
+	if strategy == ConflictStrategyRename && res.GVR() == namespaceGVR {
+		strategy = ConflictStrategySkip
+	}
+
+	switch strategy {
+	case "":
+		item.Action = PreviewActionExists
+		item.Reason = p.localize(msgkey.ResourceAlreadyExists)
+	case ConflictStrategySkip:
+		item.Action = PreviewActionSkipped
+		item.Reason = p.localize(msgkey.SyncSkippedExisting)
+	case ConflictStrategyOverwrite:
+		item.Action = PreviewActionOverwrite
+		if err := p.verber.Update(obj.DeepCopy()); err != nil {
+			return p.failedItem(item.Namespace, item.Kind, item.Name, reasonOf(err), err)
+		}
+		item.ServerValidated = true
+	case ConflictStrategyRename:
+		targetName, err := p.renamedName(res, obj)
+		if err != nil {
+			return p.failedItem(item.Namespace, item.Kind, item.Name, reasonOf(err), err)
+		}
+		if targetName == "" {
+			return p.failedItem(item.Namespace, item.Kind, item.Name, msgkey.SyncRenameExhausted, nil)
+		}
+		renamed := obj.DeepCopy()
+		renamed.SetName(targetName)
+		item.Action = PreviewActionRename
+		item.TargetName = targetName
+		item.Manifest = renamed.Object
+		// the renamed object does not exist, so there is nothing to compare it with
+		item.Diff = nil
+		if !validate {
+			return item
+		}
+		_, err = resourceClient(p.karmada, res, obj.GetNamespace()).Create(p.ctx, renamed, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		if err != nil {
+			return p.failedItem(item.Namespace, item.Kind, item.Name, reasonOf(err), err)
+		}
+		item.ServerValidated = true
+	}
+	return item
+}
+
+// renamedName returns the first suffixed name of obj that is not taken in Karmada, the same name the runner would
+// create it under, or "" if every attempt is taken.
+func (p *syncPreviewer) renamedName(res SyncResourceKind, obj *unstructured.Unstructured) (string, error) {
+	for attempt := 1; attempt <= maxRenameAttempts; attempt++ {
+		name := renamedName(obj.GetName(), p.request.RenameSuffix, attempt)
+		_, err := resourceClient(p.karmada, res, obj.GetNamespace()).Get(p.ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return name, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func (p *syncPreviewer) failedGroup(nsRes SyncRequestData, reason string, err error) []SyncPreviewItem {
+	items := make([]SyncPreviewItem, 0)
+	for _, kindGroup := range nsRes.List {
+		for _, name := range kindGroup.List {
+			items = append(items, p.failedItem(nsRes.Namespace, kindGroup.Kind, name, reason, err))
+		}
+	}
+	return items
+}
+
+// failedItem returns a failed item with the localized reason and, if err is set, its error as detail.
+func (p *syncPreviewer) failedItem(namespace, kind, name, reason string, err error) SyncPreviewItem {
+	item := SyncPreviewItem{
+		Namespace: namespace,
+		Kind:      kind,
+		Name:      name,
+		Action:    PreviewActionFailed,
+		Reason:    p.localize(reason),
+	}
+	if err != nil {
+		item.Detail = err.Error()
+	}
+	return item
+}
+
+func (p *syncPreviewer) skippedItem(namespace, kind, name, reason string) SyncPreviewItem {
+	return SyncPreviewItem{
+		Namespace: namespace,
+		Kind:      kind,
+		Name:      name,
+		Action:    PreviewActionSkipped,
+		Reason:    p.localize(reason),
+	}
+}
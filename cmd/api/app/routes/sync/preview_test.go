@@ -0,0 +1,142 @@
+package sync
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var configMapKind = SyncResourceKind{Kind: "configmap", Version: "v1", Resource: "configmaps", Namespaced: true}
+
+func configMap(name string, data map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+		"data":       data,
+	}}
+}
+
+func namespace(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   map[string]interface{}{"name": name},
+	}}
+}
+
+// updateRecorder records the updates of a dry-run verber.
+type updateRecorder struct {
+	client.ResourceVerber
+	mu      sync.Mutex
+	updated []string
+}
+
+func (v *updateRecorder) Update(object *unstructured.Unstructured) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.updated = append(v.updated, object.GetName())
+	return nil
+}
+
+func TestSyncPreviewConflictStrategy(t *testing.T) {
+	cases := []struct {
+		strategy   string
+		action     string
+		targetName string
+		reason     string
+		updated    []string
+	}{
+		{"", PreviewActionExists, "", msgkey.ResourceAlreadyExists, nil},
+		{ConflictStrategySkip, PreviewActionSkipped, "", msgkey.SyncSkippedExisting, nil},
+		{ConflictStrategyOverwrite, PreviewActionOverwrite, "", "", []string{"web"}},
+		// web-synced is taken, so the runner would create web-synced-2
+		{ConflictStrategyRename, PreviewActionRename, "web-synced-2", "", nil},
+	}
+	for _, c := range cases {
+		scheme := runtime.NewScheme()
+		member := dynamicfake.NewSimpleDynamicClient(scheme,
+			configMap("web", map[string]interface{}{"mode": "new"}),
+			configMap("api", map[string]interface{}{"mode": "new"}),
+		)
+		karmada := dynamicfake.NewSimpleDynamicClient(scheme,
+			namespace("default"),
+			configMap("web", map[string]interface{}{"mode": "old"}),
+			configMap("web-synced", map[string]interface{}{"mode": "old"}),
+		)
+		filter, _ := newSyncFilter("", false, false)
+		verber := &updateRecorder{}
+		previewer := &syncPreviewer{
+			ctx:      t.Context(),
+			member:   member,
+			karmada:  karmada,
+			verber:   verber,
+			kinds:    &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind}},
+			filter:   filter,
+			localize: func(key string) string { return key },
+		}
+		request := SyncRequest{
+			Data:             []SyncRequestData{{Namespace: "default", List: []SyncRequestResource{{Kind: "configmap", List: []string{"web", "api"}}}}},
+			ConflictStrategy: c.strategy,
+		}
+
+		items := previewer.preview(request).Items
+		if len(items) != 2 {
+			t.Fatalf("preview(%q) == %#v, expected 2 items", c.strategy, items)
+		}
+		web, api := items[0], items[1]
+		if web.Action != c.action || web.TargetName != c.targetName || web.Reason != c.reason {
+			t.Errorf("preview(%q) web == %s %q %q, expected %s %q %q", c.strategy, web.Action, web.TargetName, web.Reason, c.action, c.targetName, c.reason)
+		}
+		if c.action != PreviewActionRename && len(web.Diff) == 0 {
+			t.Errorf("preview(%q) web has no diff with the karmada object", c.strategy)
+		}
+		if !reflect.DeepEqual(verber.updated, c.updated) {
+			t.Errorf("preview(%q) updated %v, expected %v", c.strategy, verber.updated, c.updated)
+		}
+		if api.Action != PreviewActionCreate || !api.ServerValidated {
+			t.Errorf("preview(%q) api == %s validated %v, expected a validated create", c.strategy, api.Action, api.ServerValidated)
+		}
+	}
+}
+
+func TestSyncPreviewFailureReasons(t *testing.T) {
+	scheme := runtime.NewScheme()
+	filter, _ := newSyncFilter("", false, false)
+	previewer := &syncPreviewer{
+		ctx:      t.Context(),
+		member:   dynamicfake.NewSimpleDynamicClient(scheme),
+		karmada:  dynamicfake.NewSimpleDynamicClient(scheme, namespace("default")),
+		kinds:    &SyncResourceKinds{kinds: map[string]SyncResourceKind{"configmap": configMapKind}},
+		filter:   filter,
+		localize: func(key string) string { return "localized " + key },
+	}
+	request := SyncRequest{Data: []SyncRequestData{
+		{Namespace: "default", List: []SyncRequestResource{{Kind: "configmap", List: []string{"missing"}}, {Kind: "widget", List: []string{"w"}}}},
+		{Namespace: "", List: []SyncRequestResource{{Kind: "configmap", List: []string{"c"}}}},
+		{Namespace: "absent", List: []SyncRequestResource{{Kind: "configmap", List: []string{"a"}}}},
+	}}
+
+	reasons := make([]string, 0)
+	for _, item := range previewer.preview(request).Items {
+		if item.Action != PreviewActionFailed {
+			t.Errorf("preview() %s == %s, expected failed", item.Name, item.Action)
+		}
+		reasons = append(reasons, item.Reason)
+	}
+	expected := []string{
+		"localized " + msgkey.SyncSourceNotFound,
+		"localized " + msgkey.UnsupportedResourceKind,
+		"localized " + msgkey.ResourceNamespaceRequired,
+		"localized " + msgkey.NamespaceNotFound,
+	}
+	if !reflect.DeepEqual(reasons, expected) {
+		t.Errorf("preview() reasons == %v, expected %v", reasons, expected)
+	}
+}
@@ -0,0 +1,139 @@
+package sync
+
+import (
+	gosync "sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	"github.com/karmada-io/dashboard/cmd/api/app/localize"
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// defaultSyncReportHistorySize is used when SyncReportHistorySize is not configured.
+const defaultSyncReportHistorySize = 20
+
+var syncReports = newSyncReportStore()
+
+// SyncReport is the itemized result of a sync from a member cluster.
+type SyncReport struct {
+	ID        string `json:"id"`
+	ClusterId string `json:"clusterId"`
+	// JobID is the id of the sync job that produced the report
+	JobID            string      `json:"jobId,omitempty"`
+	ConflictStrategy string      `json:"conflictStrategy,omitempty"`
+	StartedAt        metav1.Time `json:"startedAt"`
+	FinishedAt       metav1.Time `json:"finishedAt"`
+	// Dependencies are the objects added to the sync by includeDependencies
+	Dependencies []SyncDependency `json:"dependencies,omitempty"`
+	// Policies are the propagation policies generated by createPropagationPolicy
+	Policies []SyncPolicyResult `json:"policies,omitempty"`
+	SyncResponse
+}
+
+func newSyncReport(clusterID string, request SyncRequest) *SyncReport {
+	return &SyncReport{
+		ID:               string(uuid.NewUUID()),
+		ClusterId:        clusterID,
+		ConflictStrategy: request.ConflictStrategy,
+		StartedAt:        metav1.Now(),
+	}
+}
+
+// syncReportStore keeps the latest sync reports of each cluster in memory.
+type syncReportStore struct {
+	mu      gosync.RWMutex
+	reports map[string][]*SyncReport
+}
+
+func newSyncReportStore() *syncReportStore {
+	return &syncReportStore{reports: make(map[string][]*SyncReport)}
+}
+
+func (s *syncReportStore) add(report *SyncReport) {
+	size := defaultSyncReportHistorySize
+	if intra.Env != nil && intra.Env.SyncReportHistorySize > 0 {
+		size = intra.Env.SyncReportHistorySize
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reports := append(s.reports[report.ClusterId], report)
+	if len(reports) > size {
+		reports = reports[len(reports)-size:]
+	}
+	s.reports[report.ClusterId] = reports
+}
+
+// list returns the reports of the cluster, the latest first.
+func (s *syncReportStore) list(clusterID string) []SyncReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	reports := s.reports[clusterID]
+	result := make([]SyncReport, 0, len(reports))
+	for i := len(reports) - 1; i >= 0; i-- {
+		result = append(result, *reports[i])
+	}
+	return result
+}
+
+func (s *syncReportStore) get(clusterID, id string) (SyncReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, report := range s.reports[clusterID] {
+		if report.ID == id {
+			return *report, true
+		}
+	}
+	return SyncReport{}, false
+}
+
+// finish records the result of the sync and stores the report.
+func (r *SyncReport) finish(result SyncResponse) {
+	r.SyncResponse = result
+	r.FinishedAt = metav1.NewTime(time.Now())
+	syncReports.add(r)
+}
+
+// localizeReport returns a copy of report with the reasons translated to the language of the request.
+func localizeReport(c *gin.Context, report SyncReport) SyncReport {
+	items := make([]SyncResultItem, 0, len(report.Items))
+	for _, item := range report.Items {
+		if item.Reason != "" {
+			item.Reason = localize.GetLocalizeMessage(c, item.Reason)
+		}
+		items = append(items, item)
+	}
+	report.Items = items
+
+	policies := make([]SyncPolicyResult, 0, len(report.Policies))
+	for _, policy := range report.Policies {
+		if policy.Reason != "" {
+			policy.Reason = localize.GetLocalizeMessage(c, policy.Reason)
+		}
+		policies = append(policies, policy)
+	}
+	if len(policies) > 0 {
+		report.Policies = policies
+	}
+	return report
+}
+
+// reasonOf returns the message key describing a Kubernetes error.
+func reasonOf(err error) string {
+	switch {
+	case errors.IsNotFound(err):
+		return msgkey.ResourceNotFound
+	case errors.IsAlreadyExists(err), errors.IsConflict(err):
+		return msgkey.ResourceAlreadyExists
+	case errors.IsInvalid(err):
+		return msgkey.ResourceUnprocessableEntity
+	case errors.IsForbidden(err), errors.IsUnauthorized(err):
+		return msgkey.SyncPermissionDenied
+	default:
+		return msgkey.ResourceOperationFailed
+	}
+}
@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+)
+
+func TestSyncReportStore(t *testing.T) {
+	previous := intra.Env.SyncReportHistorySize
+	defer func() { intra.Env.SyncReportHistorySize = previous }()
+	intra.Env.SyncReportHistorySize = 3
+
+	store := newSyncReportStore()
+	for _, id := range []string{"r1", "r2", "r3", "r4"} {
+		store.add(&SyncReport{ID: id, ClusterId: "member1"})
+	}
+	store.add(&SyncReport{ID: "r5", ClusterId: "member2"})
+
+	// the oldest report is trimmed and the latest is listed first
+	ids := make([]string, 0)
+	for _, report := range store.list("member1") {
+		ids = append(ids, report.ID)
+	}
+	if expected := []string{"r4", "r3", "r2"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("list(member1) == %v, expected %v", ids, expected)
+	}
+	if reports := store.list("member3"); reports == nil || len(reports) != 0 {
+		t.Errorf("list(member3) == %#v, expected an empty list", reports)
+	}
+
+	cases := []struct {
+		clusterID string
+		id        string
+		found     bool
+	}{
+		{"member1", "r3", true},
+		{"member2", "r5", true},
+		// trimmed
+		{"member1", "r1", false},
+		// reports are only found in their cluster
+		{"member1", "r5", false},
+		{"member3", "r2", false},
+	}
+	for _, c := range cases {
+		report, ok := store.get(c.clusterID, c.id)
+		if ok != c.found || (ok && report.ID != c.id) {
+			t.Errorf("get(%s, %s) == %#v, %t expected found %t", c.clusterID, c.id, report, ok, c.found)
+		}
+	}
+}
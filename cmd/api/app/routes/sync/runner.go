@@ -0,0 +1,296 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/msgkey"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// Conflict strategies applied when an object already exists in Karmada.
+// Without a strategy the object is counted as failed.
+const (
+	// ConflictStrategySkip leaves the Karmada object as it is and counts the object as skipped.
+	ConflictStrategySkip = "skip"
+	// ConflictStrategyOverwrite replaces the Karmada object with a three-way merge update.
+	ConflictStrategyOverwrite = "overwrite"
+	// ConflictStrategyRename creates the object under its name with a suffix.
+	ConflictStrategyRename = "rename"
+)
+
+// Outcomes of a synced object.
+const (
+	OutcomeCreated     = "created"
+	OutcomeOverwritten = "overwritten"
+	OutcomeSkipped     = "skipped"
+	OutcomeFailed      = "failed"
+	// OutcomeNamespaceMissing means the namespace of the object does not exist in Karmada
+	OutcomeNamespaceMissing = "namespace-missing"
+)
+
+const (
+	defaultRenameSuffix = "-synced"
+	// maxRenameAttempts is the number of suffixed names tried before a renamed object fails
+	maxRenameAttempts = 5
+)
+
+func isValidConflictStrategy(strategy string) bool {
+	switch strategy {
+	case "", ConflictStrategySkip, ConflictStrategyOverwrite, ConflictStrategyRename:
+		return true
+	}
+	return false
+}
+
+type SyncResultItem struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	// TargetName is the name of the object in Karmada if it was renamed
+	TargetName string `json:"targetName,omitempty"`
+	Outcome    string `json:"outcome"`
+	// ConflictStrategy is the strategy applied because the object already existed in Karmada
+	ConflictStrategy string `json:"conflictStrategy,omitempty"`
+	// Reason is the message key of the failure reason, it is localized when the result is returned
+	Reason string `json:"reason,omitempty"`
+	// Detail is the error returned by Kubernetes
+	Detail string `json:"detail,omitempty"`
+}
+
+// fail marks the item as failed because of err.
+func (item *SyncResultItem) fail(err error) {
+	item.Outcome = OutcomeFailed
+	item.Reason = reasonOf(err)
+	item.Detail = err.Error()
+}
+
+// syncRunner creates the objects of a sync request in Karmada.
+type syncRunner struct {
+	ctx     context.Context
+	member  dynamic.Interface
+	karmada dynamic.Interface
+	verber  client.ResourceVerber
+	kinds   *SyncResourceKinds
+	filter  *syncFilter
+	request SyncRequest
+	// canceled is checked before each object, the sync stops once it returns true
+	canceled func() bool
+	// onRecord is called with the result of each object
+	onRecord func(item SyncResultItem)
+
+	result SyncResponse
+	// synced are the objects created or overwritten in Karmada, except namespaces
+	synced []syncedObject
+}
+
+func (r *syncRunner) run() SyncResponse {
+	r.result = SyncResponse{Items: make([]SyncResultItem, 0)}
+
+	for _, createNs := range r.request.CreateNamespaces {
+		if r.isCanceled() {
+			return r.result
+		}
+		r.syncObject(namespaceKind, "", createNs)
+	}
+
+	for _, nsRes := range r.request.Data {
+		if r.isCanceled() {
+			return r.result
+		}
+		// cluster-scoped resources are requested without a namespace
+		var err error
+		if nsRes.Namespace != "" {
+			_, err = r.karmada.Resource(namespaceGVR).Get(r.ctx, nsRes.Namespace, metav1.GetOptions{})
+		}
+
+		if errors.IsNotFound(err) {
+			//리소스를 만들수 없으므로 일괄 에러처리
+			log.Printf("resources create fail: namespace that doesn't exist(%v)", nsRes.Namespace)
+			for _, kindGroup := range nsRes.List {
+				for _, name := range kindGroup.List {
+					r.record(SyncResultItem{
+						Namespace: nsRes.Namespace,
+						Kind:      kindGroup.Kind,
+						Name:      name,
+						Outcome:   OutcomeNamespaceMissing,
+						Reason:    msgkey.NamespaceNotFound,
+						Detail:    err.Error(),
+					})
+				}
+			}
+			continue
+		}
+
+		for _, kindGroup := range nsRes.List {
+			res, ok := r.kinds.Lookup(kindGroup.Kind)
+			reason := ""
+			if ok && res.Namespaced && nsRes.Namespace == "" {
+				log.Printf("%v create fail: namespace is required", kindGroup.Kind)
+				reason = msgkey.ResourceNamespaceRequired
+			} else if !ok {
+				// 지원하지 않는 kind
+				log.Printf("unsupported kind(%v)", kindGroup.Kind)
+				reason = msgkey.UnsupportedResourceKind
+			}
+
+			for _, name := range kindGroup.List {
+				if r.isCanceled() {
+					return r.result
+				}
+				if reason != "" {
+					r.record(SyncResultItem{Namespace: nsRes.Namespace, Kind: kindGroup.Kind, Name: name, Outcome: OutcomeFailed, Reason: reason})
+					continue
+				}
+				r.syncObject(res, nsRes.Namespace, name)
+			}
+		}
+	}
+	return r.result
+}
+
+func (r *syncRunner) isCanceled() bool {
+	if r.canceled == nil || !r.canceled() {
+		return false
+	}
+	log.Printf("sync canceled after %d resources", r.result.TotalResource)
+	return true
+}
+
+// syncObject copies a single object from the member cluster to Karmada.
+func (r *syncRunner) syncObject(res SyncResourceKind, namespace, name string) {
+	item := SyncResultItem{Namespace: namespace, Kind: res.Kind, Name: name, Outcome: OutcomeFailed}
+	defer func() { r.record(item) }()
+
+	// 원본 리소스 가져오기
+	srcObj, err := resourceClient(r.member, res, namespace).Get(r.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("%v create fail(%v - %v) : %v", res.Kind, namespace, name, err)
+		item.fail(err)
+		if errors.IsNotFound(err) {
+			item.Reason = msgkey.SyncSourceNotFound
+		}
+		return
+	}
+	// namespaces and dependencies are created for the requested objects and not filtered
+	if res.GVR() != namespaceGVR && !r.filter.isDependency(namespace, res.Kind, name) {
+		if reason := r.filter.excludeReason(srcObj); reason != "" {
+			log.Printf("%v skipped(%v - %v) : %v", res.Kind, namespace, name, reason)
+			item.Outcome = OutcomeSkipped
+			item.Reason = reason
+			return
+		}
+	}
+	objCopy := sanitizeObject(srcObj)
+
+	_, err = resourceClient(r.karmada, res, namespace).Create(r.ctx, objCopy, metav1.CreateOptions{})
+	if err == nil {
+		// Karmada에 Sync 성공
+		log.Printf("%v create success(%v - %v)", res.Kind, namespace, name)
+		item.Outcome = OutcomeCreated
+		r.addSynced(res, objCopy, name)
+		return
+	}
+	if !errors.IsAlreadyExists(err) || r.request.ConflictStrategy == "" {
+		log.Printf("%v create fail(%v - %v) : %v", res.Kind, namespace, name, err)
+		item.fail(err)
+		return
+	}
+
+	// Karmada에 이미 리소스 존재함
+	item.ConflictStrategy = r.request.ConflictStrategy
+	switch r.request.ConflictStrategy {
+	case ConflictStrategyOverwrite:
+		err = r.verber.Update(objCopy)
+		if err != nil {
+			log.Printf("%v overwrite fail(%v - %v) : %v", res.Kind, namespace, name, err)
+			item.fail(err)
+			return
+		}
+		item.Outcome = OutcomeOverwritten
+		r.addSynced(res, objCopy, name)
+	case ConflictStrategyRename:
+		// namespaces cannot be renamed, the resources synced into them would not follow
+		if res.GVR() == namespaceGVR {
+			item.ConflictStrategy = ConflictStrategySkip
+			item.Outcome = OutcomeSkipped
+			item.Reason = msgkey.SyncSkippedExisting
+			return
+		}
+		targetName, err := r.createRenamed(res, objCopy)
+		if err != nil {
+			log.Printf("%v rename fail(%v - %v) : %v", res.Kind, namespace, name, err)
+			item.fail(err)
+			return
+		}
+		item.TargetName = targetName
+		item.Outcome = OutcomeCreated
+		// the renamed copy is not propagated, it would be created next to the original in the source cluster
+	default:
+		item.Outcome = OutcomeSkipped
+		item.Reason = msgkey.SyncSkippedExisting
+	}
+	log.Printf("%v %v(%v - %v)", res.Kind, item.Outcome, namespace, name)
+}
+
+// renamedName returns the name tried for a renamed object in the given attempt, starting at 1.
+func renamedName(originalName, suffix string, attempt int) string {
+	if suffix == "" {
+		suffix = defaultRenameSuffix
+	}
+	if attempt > 1 {
+		return fmt.Sprintf("%s%s-%d", originalName, suffix, attempt)
+	}
+	return originalName + suffix
+}
+
+// createRenamed creates obj under the first suffixed name that is not taken in Karmada.
+func (r *syncRunner) createRenamed(res SyncResourceKind, obj *unstructured.Unstructured) (string, error) {
+	var err error
+	for attempt := 1; attempt <= maxRenameAttempts; attempt++ {
+		name := renamedName(obj.GetName(), r.request.RenameSuffix, attempt)
+		renamed := obj.DeepCopy()
+		renamed.SetName(name)
+		_, err = resourceClient(r.karmada, res, obj.GetNamespace()).Create(r.ctx, renamed, metav1.CreateOptions{})
+		if err == nil {
+			return name, nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return "", err
+		}
+	}
+	return "", err
+}
+
+func (r *syncRunner) addSynced(res SyncResourceKind, obj *unstructured.Unstructured, name string) {
+	if res.GVR() == namespaceGVR {
+		return
+	}
+	r.synced = append(r.synced, syncedObject{
+		Namespace:  obj.GetNamespace(),
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       name,
+	})
+}
+
+func (r *syncRunner) record(item SyncResultItem) {
+	r.result.TotalResource++
+	switch item.Outcome {
+	case OutcomeFailed, OutcomeNamespaceMissing:
+		r.result.FailResource++
+	case OutcomeSkipped:
+		r.result.SkipResource++
+	default:
+		r.result.SuccessResource++
+	}
+	r.result.Items = append(r.result.Items, item)
+	if r.onRecord != nil {
+		r.onRecord(item)
+	}
+}
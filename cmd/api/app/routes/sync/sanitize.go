@@ -0,0 +1,272 @@
+package sync
+
+import (
+	"os"
+	"path"
+	"slices"
+	"strings"
+	gosync "sync"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/intra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// anyKind is the kind of the profile applied to objects of every kind.
+const anyKind = "*"
+
+// SanitizeProfile declares what is removed from objects of a kind read from a member cluster
+// before they are created in Karmada.
+type SanitizeProfile struct {
+	// Kind is the group kind the profile applies to (e.g. Service, Job.batch), or "*" for every kind
+	Kind string `json:"kind"`
+	// Fields are dot separated field paths. A segment ending with [] applies the rest of the path
+	// to every item of the list, e.g. spec.ports[].nodePort
+	Fields []string `json:"fields,omitempty"`
+	// PreservedValues are the values kept in a field listed in Fields, by field path. A list is kept if all its
+	// items are preserved values, e.g. the None cluster IPs of a headless service
+	PreservedValues map[string][]string `json:"preservedValues,omitempty"`
+	// Finalizers are removed from the object metadata, * matches any characters except /
+	Finalizers []string `json:"finalizers,omitempty"`
+	// Labels and Annotations are keys removed from the object metadata, * matches any characters except /
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+	// TemplateLabels are keys removed from the labels of the pod template in spec.template
+	TemplateLabels []string `json:"templateLabels,omitempty"`
+}
+
+// SanitizeProfiles is the format of the file configured with SyncSanitizeProfileFile.
+// Its profiles are added to the built-in profiles of the same kind.
+type SanitizeProfiles struct {
+	Profiles []SanitizeProfile `json:"profiles"`
+}
+
+// karmadaKeys match the labels and annotations Karmada sets on the objects it manages.
+var karmadaKeys = []string{"karmada.io/*", "*.karmada.io/*"}
+
+// builtinSanitizeProfiles remove the fields that are set by the member cluster and would fail or
+// misbehave when the object is created in Karmada.
+var builtinSanitizeProfiles = []SanitizeProfile{
+	{
+		Kind: anyKind,
+		Fields: []string{
+			"status",
+			"metadata.resourceVersion",
+			"metadata.uid",
+			"metadata.creationTimestamp",
+			"metadata.deletionTimestamp",
+			"metadata.deletionGracePeriodSeconds",
+			"metadata.managedFields",
+			"metadata.generation",
+			"metadata.selfLink",
+			"metadata.ownerReferences",
+		},
+		// finalizers of the member cluster controllers, which would block the deletion in Karmada
+		Finalizers:  append([]string{"kubernetes.io/*", "*.kubernetes.io/*", "foregroundDeletion", "orphan"}, karmadaKeys...),
+		Labels:      karmadaKeys,
+		Annotations: append([]string{"kubectl.kubernetes.io/last-applied-configuration"}, karmadaKeys...),
+	},
+	{
+		Kind:        "Deployment.apps",
+		Annotations: []string{"deployment.kubernetes.io/revision"},
+	},
+	{
+		Kind: "Job.batch",
+		// the selector and its labels are generated for the uid of the job in the member cluster
+		Fields:         []string{"spec.selector"},
+		Labels:         []string{"controller-uid", "job-name", "batch.kubernetes.io/controller-uid", "batch.kubernetes.io/job-name"},
+		TemplateLabels: []string{"controller-uid", "job-name", "batch.kubernetes.io/controller-uid", "batch.kubernetes.io/job-name"},
+	},
+	{
+		Kind: "Service",
+		// allocated by each member cluster, except the None cluster IP of a headless service
+		Fields: []string{"spec.clusterIP", "spec.clusterIPs", "spec.ports[].nodePort", "spec.healthCheckNodePort"},
+		PreservedValues: map[string][]string{
+			"spec.clusterIP":  {"None"},
+			"spec.clusterIPs": {"None"},
+		},
+	},
+	{
+		Kind: "PersistentVolumeClaim",
+		// the claim is bound to a volume of the member cluster
+		Fields: []string{"spec.volumeName"},
+		Annotations: []string{
+			"pv.kubernetes.io/bind-completed",
+			"pv.kubernetes.io/bound-by-controller",
+			"volume.beta.kubernetes.io/storage-provisioner",
+			"volume.kubernetes.io/storage-provisioner",
+			"volume.kubernetes.io/selected-node",
+		},
+	},
+	{
+		Kind: "ServiceAccount",
+		// token secrets generated by the member cluster
+		Fields: []string{"secrets"},
+	},
+}
+
+var (
+	sanitizeProfilesOnce gosync.Once
+	sanitizeProfiles     map[string][]SanitizeProfile
+)
+
+// profilesFor returns the profiles applied to objects of the group kind.
+func profilesFor(groupKind string) []SanitizeProfile {
+	sanitizeProfilesOnce.Do(func() {
+		profiles := builtinSanitizeProfiles
+		if intra.Env != nil && intra.Env.SyncSanitizeProfileFile != "" {
+			configured, err := loadSanitizeProfiles(intra.Env.SyncSanitizeProfileFile)
+			if err != nil {
+				klog.Errorf("Failed to load sanitize profiles, using the built-in profiles: %v", err)
+			} else {
+				profiles = append(append([]SanitizeProfile{}, profiles...), configured...)
+			}
+		}
+		sanitizeProfiles = indexSanitizeProfiles(profiles)
+	})
+	return append(append([]SanitizeProfile{}, sanitizeProfiles[anyKind]...), sanitizeProfiles[groupKind]...)
+}
+
+func loadSanitizeProfiles(file string) ([]SanitizeProfile, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var profiles SanitizeProfiles
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles.Profiles, nil
+}
+
+func indexSanitizeProfiles(profiles []SanitizeProfile) map[string][]SanitizeProfile {
+	index := make(map[string][]SanitizeProfile)
+	for _, profile := range profiles {
+		index[profile.Kind] = append(index[profile.Kind], profile)
+	}
+	return index
+}
+
+// sanitizeObject returns a copy of obj read from a member cluster that can be created in Karmada.
+func sanitizeObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	return applySanitizeProfiles(obj, profilesFor(obj.GroupVersionKind().GroupKind().String()))
+}
+
+func applySanitizeProfiles(obj *unstructured.Unstructured, profiles []SanitizeProfile) *unstructured.Unstructured {
+	objCopy := obj.DeepCopy()
+	for _, profile := range profiles {
+		for _, field := range profile.Fields {
+			removeField(objCopy.Object, strings.Split(field, "."), profile.PreservedValues[field])
+		}
+		removeItems(objCopy.Object, profile.Finalizers, "metadata", "finalizers")
+		removeKeys(objCopy.Object, profile.Labels, "metadata", "labels")
+		removeKeys(objCopy.Object, profile.Annotations, "metadata", "annotations")
+		removeKeys(objCopy.Object, profile.TemplateLabels, "spec", "template", "metadata", "labels")
+	}
+	return objCopy
+}
+
+// removeField removes the field at path, applying the rest of the path to every list item for segments ending with [].
+// The field is kept if its value is one of the preserved values.
+func removeField(obj map[string]interface{}, path []string, preserved []string) {
+	if len(path) == 0 {
+		return
+	}
+	segment := path[0]
+	list, isList := strings.CutSuffix(segment, "[]")
+	if !isList {
+		if len(path) == 1 {
+			if !isPreserved(obj[segment], preserved) {
+				delete(obj, segment)
+			}
+			return
+		}
+		if child, ok := obj[segment].(map[string]interface{}); ok {
+			removeField(child, path[1:], preserved)
+		}
+		return
+	}
+
+	items, ok := obj[list].([]interface{})
+	if !ok {
+		return
+	}
+	for _, item := range items {
+		if child, ok := item.(map[string]interface{}); ok {
+			removeField(child, path[1:], preserved)
+		}
+	}
+}
+
+// isPreserved reports whether value is a preserved string, or a non-empty list of preserved strings.
+func isPreserved(value interface{}, preserved []string) bool {
+	if len(preserved) == 0 {
+		return false
+	}
+	switch v := value.(type) {
+	case string:
+		return slices.Contains(preserved, v)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); !ok || !slices.Contains(preserved, s) {
+				return false
+			}
+		}
+		return len(v) > 0
+	}
+	return false
+}
+
+// removeItems removes the items matching any of the patterns from the string list at fields, and the list itself
+// if it becomes empty.
+func removeItems(obj map[string]interface{}, patterns []string, fields ...string) {
+	if len(patterns) == 0 {
+		return
+	}
+	items, found, err := unstructured.NestedStringSlice(obj, fields...)
+	if err != nil || !found {
+		return
+	}
+	kept := make([]string, 0, len(items))
+	for _, item := range items {
+		if !matchesKey(patterns, item) {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return
+	}
+	_ = unstructured.SetNestedStringSlice(obj, kept, fields...)
+}
+
+// removeKeys removes the keys matching any of the patterns from the string map at fields, and the map itself if it becomes empty.
+func removeKeys(obj map[string]interface{}, patterns []string, fields ...string) {
+	if len(patterns) == 0 {
+		return
+	}
+	values, found, err := unstructured.NestedMap(obj, fields...)
+	if err != nil || !found {
+		return
+	}
+	for key := range values {
+		if matchesKey(patterns, key) {
+			delete(values, key)
+		}
+	}
+	if len(values) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return
+	}
+	_ = unstructured.SetNestedMap(obj, values, fields...)
+}
+
+func matchesKey(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
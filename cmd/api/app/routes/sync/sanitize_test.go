@@ -0,0 +1,262 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// memberMetadata returns metadata as set by a member cluster on an object managed by Karmada.
+func memberMetadata(name string, labels, annotations map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"name":              name,
+		"namespace":         "default",
+		"resourceVersion":   "12345",
+		"uid":               "0b7c5c1e-8f3e-4e8a-9d8c-7f1a2b3c4d5e",
+		"creationTimestamp": "2025-01-01T00:00:00Z",
+		"generation":        int64(3),
+		"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		"ownerReferences":   []interface{}{map[string]interface{}{"kind": "HelmRelease", "name": "app"}},
+		"finalizers":        []interface{}{"example.com/cleanup", "kubernetes.io/pvc-protection", "foregroundDeletion", "resourcebinding.karmada.io/dependencies-distributor"},
+		"labels": map[string]interface{}{
+			"app": name,
+			"propagationpolicy.karmada.io/permanent-id": "7c6c1e2a",
+			"karmada.io/managed":                        "true",
+		},
+		"annotations": map[string]interface{}{
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			"resourcetemplate.karmada.io/uid":                  "1a2b3c",
+			"team":                                             "payments",
+		},
+	}
+	for k, v := range labels {
+		metadata["labels"].(map[string]interface{})[k] = v
+	}
+	for k, v := range annotations {
+		metadata["annotations"].(map[string]interface{})[k] = v
+	}
+	return metadata
+}
+
+// sanitizedMetadata is memberMetadata after the profile applied to every kind, the user finalizer is kept.
+func sanitizedMetadata(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"namespace":   "default",
+		"finalizers":  []interface{}{"example.com/cleanup"},
+		"labels":      map[string]interface{}{"app": name},
+		"annotations": map[string]interface{}{"team": "payments"},
+	}
+}
+
+func TestSanitizeObject(t *testing.T) {
+	cases := []struct {
+		name     string
+		object   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			"ConfigMap",
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   memberMetadata("config", nil, nil),
+				"data":       map[string]interface{}{"key": "value"},
+			},
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   sanitizedMetadata("config"),
+				"data":       map[string]interface{}{"key": "value"},
+			},
+		},
+		{
+			"Deployment",
+			map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   memberMetadata("web", nil, map[string]interface{}{"deployment.kubernetes.io/revision": "4"}),
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status":     map[string]interface{}{"readyReplicas": int64(2)},
+			},
+			map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   sanitizedMetadata("web"),
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+			},
+		},
+		{
+			"Job",
+			map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata": memberMetadata("migrate", map[string]interface{}{
+					"controller-uid":                     "abc",
+					"job-name":                           "migrate",
+					"batch.kubernetes.io/controller-uid": "abc",
+					"batch.kubernetes.io/job-name":       "migrate",
+				}, nil),
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"controller-uid": "abc"}},
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"labels": map[string]interface{}{
+								"app":                                "migrate",
+								"controller-uid":                     "abc",
+								"job-name":                           "migrate",
+								"batch.kubernetes.io/controller-uid": "abc",
+								"batch.kubernetes.io/job-name":       "migrate",
+							},
+						},
+					},
+				},
+				"status": map[string]interface{}{"succeeded": int64(1)},
+			},
+			map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   sanitizedMetadata("migrate"),
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"labels": map[string]interface{}{"app": "migrate"},
+						},
+					},
+				},
+			},
+		},
+		{
+			"Service",
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   memberMetadata("web", nil, nil),
+				"spec": map[string]interface{}{
+					"type":                "LoadBalancer",
+					"clusterIP":           "10.96.0.10",
+					"clusterIPs":          []interface{}{"10.96.0.10"},
+					"healthCheckNodePort": int64(32000),
+					"ports": []interface{}{
+						map[string]interface{}{"port": int64(80), "nodePort": int64(30080)},
+						map[string]interface{}{"port": int64(443), "nodePort": int64(30443)},
+					},
+				},
+				"status": map[string]interface{}{"loadBalancer": map[string]interface{}{}},
+			},
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   sanitizedMetadata("web"),
+				"spec": map[string]interface{}{
+					"type": "LoadBalancer",
+					"ports": []interface{}{
+						map[string]interface{}{"port": int64(80)},
+						map[string]interface{}{"port": int64(443)},
+					},
+				},
+			},
+		},
+		{
+			"headless Service",
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   memberMetadata("db", nil, nil),
+				"spec": map[string]interface{}{
+					"clusterIP":  "None",
+					"clusterIPs": []interface{}{"None"},
+					"ports":      []interface{}{map[string]interface{}{"port": int64(5432)}},
+				},
+			},
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   sanitizedMetadata("db"),
+				"spec": map[string]interface{}{
+					"clusterIP":  "None",
+					"clusterIPs": []interface{}{"None"},
+					"ports":      []interface{}{map[string]interface{}{"port": int64(5432)}},
+				},
+			},
+		},
+		{
+			"PersistentVolumeClaim",
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata": memberMetadata("data", nil, map[string]interface{}{
+					"pv.kubernetes.io/bind-completed":          "yes",
+					"pv.kubernetes.io/bound-by-controller":     "yes",
+					"volume.kubernetes.io/storage-provisioner": "csi.example.com",
+					"volume.kubernetes.io/selected-node":       "node-1",
+				}),
+				"spec": map[string]interface{}{
+					"storageClassName": "standard",
+					"volumeName":       "pvc-0b7c5c1e",
+				},
+				"status": map[string]interface{}{"phase": "Bound"},
+			},
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   sanitizedMetadata("data"),
+				"spec":       map[string]interface{}{"storageClassName": "standard"},
+			},
+		},
+		{
+			"ServiceAccount",
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ServiceAccount",
+				"metadata":   memberMetadata("app", nil, nil),
+				"secrets":    []interface{}{map[string]interface{}{"name": "app-token-x7k2p"}},
+			},
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ServiceAccount",
+				"metadata":   sanitizedMetadata("app"),
+			},
+		},
+	}
+	for _, c := range cases {
+		obj := &unstructured.Unstructured{Object: c.object}
+		original := obj.DeepCopy()
+		actual := sanitizeObject(obj)
+		if !reflect.DeepEqual(actual.Object, c.expected) {
+			t.Errorf("sanitizeObject(%s) == \n%#v\nexpected \n%#v\n", c.name, actual.Object, c.expected)
+		}
+		if !reflect.DeepEqual(obj, original) {
+			t.Errorf("sanitizeObject(%s) modified the original object", c.name)
+		}
+	}
+}
+
+func TestApplyConfiguredSanitizeProfiles(t *testing.T) {
+	profiles := indexSanitizeProfiles(append(builtinSanitizeProfiles, SanitizeProfile{
+		Kind:        "Service",
+		Fields:      []string{"spec.loadBalancerIP"},
+		Annotations: []string{"metallb.universe.tf/*"},
+	}))
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":        "web",
+			"annotations": map[string]interface{}{"metallb.universe.tf/address-pool": "public"},
+		},
+		"spec": map[string]interface{}{"clusterIP": "10.96.0.10", "loadBalancerIP": "192.0.2.10"},
+	}}
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "web"},
+		"spec":       map[string]interface{}{},
+	}
+
+	actual := applySanitizeProfiles(obj, append(profiles[anyKind], profiles["Service"]...))
+	if !reflect.DeepEqual(actual.Object, expected) {
+		t.Errorf("applySanitizeProfiles() == \n%#v\nexpected \n%#v\n", actual.Object, expected)
+	}
+}
@@ -19,6 +19,7 @@ package v1
 import (
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PostClusterRequest is the request body for creating a cluster.
@@ -71,13 +72,50 @@ type DeleteClusterResponse struct {
 }
 
 type Usage struct {
-	CPU    float64 `json:"cpu"`
-	Memory float64 `json:"memory"`
+	CPU         float64      `json:"cpu"`
+	Memory      float64      `json:"memory"`
+	CollectedAt *metav1.Time `json:"collectedAt"`
+	Stale       bool         `json:"stale"`
+	// Missing is set if no value was collected for the cluster.
+	Missing bool `json:"missing"`
 }
 
+// InitUsage returns the usage of a cluster without any collected value. It is always stale and missing.
 func InitUsage() Usage {
 	return Usage{
-		CPU:    -1,
-		Memory: -1,
+		CPU:     -1,
+		Memory:  -1,
+		Stale:   true,
+		Missing: true,
 	}
 }
+
+// UsageStat holds the aggregates of one usage value within a sample window.
+type UsageStat struct {
+	Min float64 `json:"min"`
+	Avg float64 `json:"avg"`
+	Max float64 `json:"max"`
+}
+
+// UsageSummary holds the aggregated CPU and memory usage within a sample window.
+type UsageSummary struct {
+	CPU    UsageStat `json:"cpu"`
+	Memory UsageStat `json:"memory"`
+}
+
+// ClusterUsageSample is one downsampled point of the cluster usage time series.
+type ClusterUsageSample struct {
+	Time          metav1.Time  `json:"time"`
+	Count         int          `json:"count"`
+	RealTimeUsage UsageSummary `json:"realTimeUsage"`
+	RequestUsage  UsageSummary `json:"requestUsage"`
+}
+
+// ClusterUsageHistory is the response body for the cluster usage time series.
+type ClusterUsageHistory struct {
+	ClusterId string               `json:"clusterId"`
+	From      metav1.Time          `json:"from"`
+	To        metav1.Time          `json:"to"`
+	Step      string               `json:"step"`
+	Samples   []ClusterUsageSample `json:"samples"`
+}
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"github.com/karmada-io/dashboard/pkg/common/types"
+	"gomodules.xyz/jsonpatch/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
@@ -10,10 +12,48 @@ type ResourceYaml struct {
 	Name      string       `json:"name"`
 	UID       k8stypes.UID `json:"uid"`
 	Yaml      string       `json:"yaml"`
+	// Policy is the policy propagating the resource, set by the resources API
+	Policy *PolicyMeta `json:"policy,omitempty"`
+	// Propagation is the propagation of the resource to its target clusters, set by the resources API
+	Propagation *PropagationStatus `json:"propagation,omitempty"`
+	// AggregatedStatus is the status of a workload in its target clusters, set for Deployments, StatefulSets,
+	// DaemonSets and Jobs
+	AggregatedStatus *AggregatedWorkloadStatus `json:"aggregatedStatus,omitempty"`
 }
 
 type ManifestRequest struct {
 	Data string `json:"data" binding:"required"`
+	// StopOnError stops applying a multi-document manifest at the first failed document,
+	// the remaining documents are skipped. By default every document is applied.
+	StopOnError bool `json:"stopOnError"`
+}
+
+// ManifestDocumentResult is the result of applying one object of a multi-document manifest.
+type ManifestDocumentResult struct {
+	// Index is the position of the object in the manifest, the items of a List count as separate objects
+	Index      int    `json:"index"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Outcome is created, applied, failed or skipped
+	Outcome string `json:"outcome"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	// Detail is the error returned by Kubernetes
+	Detail string `json:"detail,omitempty"`
+	// Conflicts are the fields of a failed server-side apply that are managed by other managers
+	Conflicts []ApplyConflict `json:"conflicts,omitempty"`
+}
+
+type ManifestApplyResult struct {
+	// DryRun is set if the manifest was only validated
+	DryRun    bool                     `json:"dryRun"`
+	Total     int                      `json:"total"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Skipped   int                      `json:"skipped"`
+	Results   []ManifestDocumentResult `json:"results"`
 }
 
 type ResourceList struct {
@@ -26,9 +66,288 @@ type Resource struct {
 	Name      string            `json:"name"`
 	Labels    map[string]string `json:"labels"`
 	Policy    PolicyMeta        `json:"policy"`
+	// Columns are the kind specific columns, e.g. the type and ports of a Service
+	Columns map[string]interface{} `json:"columns,omitempty"`
+	// Propagation summarizes the health of the target clusters, unset if the resource is not propagated
+	Propagation *PropagationSummary `json:"propagation,omitempty"`
+}
+
+// PropagationSummary is the number of healthy target clusters of a resource, e.g. 3/4 clusters healthy.
+type PropagationSummary struct {
+	HealthyClusters int `json:"healthyClusters"`
+	TotalClusters   int `json:"totalClusters"`
+	// FullyApplied is whether the resource is applied to all its target clusters
+	FullyApplied bool `json:"fullyApplied"`
+}
+
+// PropagationStatus is the propagation of a resource, read from its ResourceBinding or ClusterResourceBinding.
+type PropagationStatus struct {
+	PropagationSummary
+	BindingKind string `json:"bindingKind"`
+	BindingName string `json:"bindingName"`
+	// Conditions are the conditions of the binding, e.g. Scheduled and FullyApplied
+	Conditions []metav1.Condition   `json:"conditions"`
+	Clusters   []ClusterPropagation `json:"clusters"`
+}
+
+// ClusterPropagation is the propagation of a resource to one of its target clusters.
+type ClusterPropagation struct {
+	ClusterName string `json:"clusterName"`
+	// Replicas is the number of replicas scheduled to the cluster
+	Replicas int32 `json:"replicas"`
+	// Applied is whether the resource is applied to the cluster
+	Applied bool `json:"applied"`
+	// Health is the health reported by Karmada: Healthy, Unhealthy or Unknown
+	Health string `json:"health"`
+	// ApplyError is the error of the last apply to the cluster
+	ApplyError string `json:"applyError,omitempty"`
 }
 
 type PolicyMeta struct {
 	IsClusterScope bool   `json:"isClusterScope"`
 	Name           string `json:"name"`
 }
+
+// ApplyConflict is a field of a server-side apply that is managed by another field manager.
+type ApplyConflict struct {
+	// Field is the path of the field, e.g. .spec.replicas
+	Field   string `json:"field"`
+	Manager string `json:"manager"`
+	Message string `json:"message"`
+}
+
+// AggregatedWorkloadStatus is the status of a workload merged from the Works of its target clusters,
+// with the pods and events read from the member clusters.
+type AggregatedWorkloadStatus struct {
+	WorkloadReplicas
+	Clusters []ClusterWorkloadStatus `json:"clusters"`
+	// NotReadyPods are the pods of the workload that are not ready, in all target clusters
+	NotReadyPods []NotReadyPod `json:"notReadyPods"`
+	// Events are the most recent warning events of the workload and its pods, in all target clusters
+	Events []WorkloadEvent `json:"events"`
+}
+
+// WorkloadReplicas are the replicas of a workload. Jobs have ready, active, succeeded and failed pods instead.
+type WorkloadReplicas struct {
+	Replicas          int32 `json:"replicas"`
+	ReadyReplicas     int32 `json:"readyReplicas"`
+	AvailableReplicas int32 `json:"availableReplicas"`
+	UpdatedReplicas   int32 `json:"updatedReplicas"`
+	Active            int32 `json:"active,omitempty"`
+	Succeeded         int32 `json:"succeeded,omitempty"`
+	Failed            int32 `json:"failed,omitempty"`
+}
+
+// ClusterWorkloadStatus is the status of a workload in one member cluster.
+type ClusterWorkloadStatus struct {
+	WorkloadReplicas
+	ClusterName string `json:"clusterName"`
+	// Health is the health of the workload reported in the Work
+	Health string `json:"health,omitempty"`
+	// Error is set when the status, pods or events of the cluster cannot be read
+	Error string `json:"error,omitempty"`
+}
+
+// NotReadyPod is a pod of a workload that is not ready.
+type NotReadyPod struct {
+	ClusterName string `json:"clusterName"`
+	Name        string `json:"name"`
+	Phase       string `json:"phase"`
+	// Reason is the reason of a waiting or terminated container, e.g. CrashLoopBackOff, or of the pod
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Restarts int32  `json:"restarts"`
+}
+
+// WorkloadEvent is a warning event of a workload or of one of its pods.
+type WorkloadEvent struct {
+	ClusterName string      `json:"clusterName"`
+	ObjectKind  string      `json:"objectKind"`
+	ObjectName  string      `json:"objectName"`
+	Reason      string      `json:"reason"`
+	Message     string      `json:"message"`
+	Count       int32       `json:"count"`
+	LastSeen    metav1.Time `json:"lastSeen"`
+}
+
+// TemplateRevisionList is the recorded revisions of a workload template, the oldest first.
+type TemplateRevisionList struct {
+	Revisions []TemplateRevision `json:"revisions"`
+	// CurrentRevision is the revision of the current template, 0 if it was changed outside the dashboard
+	CurrentRevision int64 `json:"currentRevision"`
+}
+
+// TemplateRevision is a recorded revision of a workload template.
+type TemplateRevision struct {
+	Revision  int64       `json:"revision"`
+	Name      string      `json:"name"`
+	CreatedAt metav1.Time `json:"createdAt"`
+	// ChangeCause is the operation that recorded the revision: create, update, apply, rollback or restart
+	ChangeCause string `json:"changeCause,omitempty"`
+	// TriggeredBy is the id of the user that triggered the change
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+	// RollbackTo is the revision restored by a rollback
+	RollbackTo int64 `json:"rollbackTo,omitempty"`
+	// Diff is the JSON patch (RFC 6902) from the previous revision, empty for the first revision
+	Diff []jsonpatch.Operation `json:"diff,omitempty"`
+}
+
+// TemplateRollback is the result of the rollback of a workload template.
+type TemplateRollback struct {
+	// Revision is the revision recorded for the rolled back template
+	Revision TemplateRevision `json:"revision"`
+	// Propagation is the propagation of the template, which Karmada updates in all its target clusters
+	Propagation *PropagationStatus `json:"propagation,omitempty"`
+}
+
+// ScaleRequest is the number of replicas of a workload template.
+type ScaleRequest struct {
+	Replicas *int32 `json:"replicas" binding:"required,min=0"`
+}
+
+// ScaleResult is the replicas of a scaled workload template and their split across its target clusters.
+type ScaleResult struct {
+	Replicas int32 `json:"replicas"`
+	// SchedulingType is the replica scheduling type of the placement: Duplicated or Divided
+	SchedulingType string `json:"schedulingType,omitempty"`
+	// Scheduled is whether Karmada scheduled the new replicas before the response, Clusters has the previous
+	// split otherwise
+	Scheduled bool `json:"scheduled"`
+	// Clusters are the replicas of each target cluster
+	Clusters []ClusterReplicas `json:"clusters"`
+}
+
+// ClusterReplicas is the number of replicas scheduled to a cluster.
+type ClusterReplicas struct {
+	ClusterName string `json:"clusterName"`
+	Replicas    int32  `json:"replicas"`
+}
+
+// RestartResult is the result of the rolling restart of a workload template.
+type RestartResult struct {
+	// RestartedAt is the time stamped in the pod template, which rolls the pods of every target cluster
+	RestartedAt string `json:"restartedAt"`
+	// Propagation is the propagation of the template to the target clusters
+	Propagation *PropagationStatus `json:"propagation,omitempty"`
+}
+
+// CronJobTriggerRequest is the target clusters in which to run a CronJob now.
+type CronJobTriggerRequest struct {
+	// Clusters are the target clusters in which a Job is created, all the target clusters if empty
+	Clusters []string `json:"clusters"`
+}
+
+// CronJobTriggerResult is the Jobs created by triggering a CronJob, by cluster.
+type CronJobTriggerResult struct {
+	Clusters []ClusterJobTrigger `json:"clusters"`
+}
+
+// ClusterJobTrigger is the Job created by triggering a CronJob in a cluster.
+type ClusterJobTrigger struct {
+	ClusterName string `json:"clusterName"`
+	JobName     string `json:"jobName,omitempty"`
+	// Error is the error of the trigger in the cluster, which does not stop the trigger in the other clusters
+	Error string `json:"error,omitempty"`
+}
+
+// CronJobSuspendResult is the result of the suspension or resumption of a CronJob template.
+type CronJobSuspendResult struct {
+	Suspended bool `json:"suspended"`
+	// Propagation is the propagation of the template, which Karmada updates in all its target clusters
+	Propagation *PropagationStatus `json:"propagation,omitempty"`
+}
+
+// CronJobJobList is the Jobs of a CronJob in its target clusters.
+type CronJobJobList struct {
+	Clusters []ClusterCronJobJobs `json:"clusters"`
+}
+
+// ClusterCronJobJobs is the CronJob of a member cluster and its Jobs, the most recent first.
+type ClusterCronJobJobs struct {
+	ClusterName string `json:"clusterName"`
+	// Suspended is whether the CronJob of the cluster is suspended, which lags behind the template until
+	// Karmada propagates it
+	Suspended        bool         `json:"suspended"`
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	Jobs             []CronJobJob `json:"jobs"`
+	// Error is the error reading the cluster
+	Error string `json:"error,omitempty"`
+}
+
+// CronJobJob is a Job of a CronJob and its completion state.
+type CronJobJob struct {
+	Name      string      `json:"name"`
+	CreatedAt metav1.Time `json:"createdAt"`
+	// State is Running, Complete or Failed
+	State string `json:"state"`
+	// Manual is whether the Job was triggered manually rather than on schedule
+	Manual         bool         `json:"manual"`
+	Active         int32        `json:"active"`
+	Succeeded      int32        `json:"succeeded"`
+	Failed         int32        `json:"failed"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ResourceDeletion is the result of the deletion of a resource.
+type ResourceDeletion struct {
+	// PolicyDeletion is orphan or cascade
+	PolicyDeletion string `json:"policyDeletion"`
+	// Policies are the policies that select only the resource, deleted with it on a cascade deletion and left
+	// behind on an orphan deletion
+	Policies []PolicyReference `json:"policies"`
+}
+
+// PolicyReference identifies a propagation or override policy.
+type PolicyReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// BulkRequest is an action on several resources, either the referenced resources or the resources of the selector.
+type BulkRequest struct {
+	// Action is delete, label, annotate, scale or restart
+	Action    string              `json:"action" binding:"required,oneof=delete label annotate scale restart"`
+	Resources []ResourceReference `json:"resources"`
+	Selector  *BulkSelector       `json:"selector"`
+	// Labels are set by the label action, a null value removes the label
+	Labels map[string]*string `json:"labels"`
+	// Annotations are set by the annotate action, a null value removes the annotation
+	Annotations map[string]*string `json:"annotations"`
+	// Replicas is set by the scale action
+	Replicas *int32 `json:"replicas" binding:"omitempty,min=0"`
+	// Policies is orphan or cascade for the delete action, orphan by default
+	Policies string `json:"policies"`
+}
+
+// ResourceReference identifies a resource of the resources API, by path kind.
+type ResourceReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// BulkSelector selects the resources of a kind by labels.
+type BulkSelector struct {
+	Kind string `json:"kind" binding:"required"`
+	// Namespace is the namespace of the resources, all namespaces if empty
+	Namespace     string `json:"namespace"`
+	LabelSelector string `json:"labelSelector" binding:"required"`
+}
+
+// BulkResult is the result of a bulk action, by resource.
+type BulkResult struct {
+	Action    string           `json:"action"`
+	DryRun    bool             `json:"dryRun"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []BulkItemResult `json:"items"`
+}
+
+// BulkItemResult is the result of a bulk action on a resource.
+type BulkItemResult struct {
+	ResourceReference
+	// Error is the error of the action on the resource, which does not stop the action on the other resources
+	Error string `json:"error,omitempty"`
+}
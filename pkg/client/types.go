@@ -30,6 +30,8 @@ const (
 	DefaultBurst = 1e6
 	// DefaultUserAgent is the default http header for user-agent
 	DefaultUserAgent = "dashboard"
+	// DefaultFieldManager is the field manager recorded for the changes made through the dashboard
+	DefaultFieldManager = "karmada-dashboard"
 	// DefaultCmdConfigName is the default cluster/context/auth name to be set in clientcmd config
 	DefaultCmdConfigName = "kubernetes"
 	// ImpersonateUserHeader is the header name to identify username to act as.
@@ -49,4 +51,14 @@ type ResourceVerber interface {
 	Delete(kind string, namespace string, name string, deleteNow bool) error
 	Create(object *unstructured.Unstructured) (*unstructured.Unstructured, error)
 	List(kind string, namespace string) (*unstructured.UnstructuredList, error)
+	// Apply creates or updates the resource with a server-side apply, force takes over the fields of other managers.
+	Apply(object *unstructured.Unstructured, force bool) (*unstructured.Unstructured, error)
+}
+
+// VerberOptions are applied to every mutating operation of a ResourceVerber.
+type VerberOptions struct {
+	// DryRun processes the operations without persisting them
+	DryRun bool
+	// FieldManager is recorded in the managed fields of the changed resources
+	FieldManager string
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gobuffalo/flect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,13 +32,16 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 )
 
 var (
-	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+	// kindToGroupVersionResource is shared by all the verbers, which may be used concurrently
+	kindToGroupVersionResource   = map[string]schema.GroupVersionResource{}
+	kindToGroupVersionResourceMu sync.RWMutex
 )
 
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
@@ -45,20 +49,33 @@ var (
 type resourceVerber struct {
 	client    dynamic.Interface
 	discovery discovery.DiscoveryInterface
+	options   VerberOptions
+}
+
+func (v *resourceVerber) dryRun() []string {
+	if v.options.DryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
 }
 
 func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructured.Unstructured) schema.GroupVersionResource {
 	gvk := object.GetObjectKind().GroupVersionKind()
 
-	return schema.GroupVersionResource{
+	gvr := schema.GroupVersionResource{
 		Group:    gvk.Group,
 		Version:  gvk.Version,
 		Resource: flect.Pluralize(strings.ToLower(gvk.Kind)),
 	}
+	// custom resources may have an irregular plural, prefer the resource name served by the API server
+	if served, err := v.groupVersionResourceFromKind(strings.ToLower(gvk.Kind)); err == nil && served.Group == gvk.Group {
+		gvr.Resource = served.Resource
+	}
+	return gvr
 }
 
 func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.GroupVersionResource, error) {
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := cachedGroupVersionResource(kind); exists {
 		klog.V(3).InfoS("GroupVersionResource cache hit", "kind", kind)
 		return gvr, nil
 	}
@@ -74,14 +91,23 @@ func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.Group
 		return schema.GroupVersionResource{}, err
 	}
 
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := cachedGroupVersionResource(kind); exists {
 		return gvr, nil
 	}
 
 	return schema.GroupVersionResource{}, fmt.Errorf("could not find GVR for kind %s", kind)
 }
 
+func cachedGroupVersionResource(kind string) (schema.GroupVersionResource, bool) {
+	kindToGroupVersionResourceMu.RLock()
+	defer kindToGroupVersionResourceMu.RUnlock()
+	gvr, exists := kindToGroupVersionResource[kind]
+	return gvr, exists
+}
+
 func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.APIResourceList) error {
+	kindToGroupVersionResourceMu.Lock()
+	defer kindToGroupVersionResourceMu.Unlock()
 	for _, resource := range resourceList {
 		gv, err := schema.ParseGroupVersion(resource.GroupVersion)
 		if err != nil {
@@ -123,6 +149,7 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, dele
 	defaultPropagationPolicy := metav1.DeletePropagationForeground
 	defaultDeleteOptions := metav1.DeleteOptions{
 		PropagationPolicy: &defaultPropagationPolicy,
+		DryRun:            v.dryRun(),
 	}
 
 	if deleteNow {
@@ -164,7 +191,10 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 		}
 
 		klog.V(3).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patch", string(patchBytes))
-		_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+		_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{
+			DryRun:       v.dryRun(),
+			FieldManager: v.options.FieldManager,
+		})
 		return updateErr
 	})
 }
@@ -192,11 +222,37 @@ func (v *resourceVerber) Create(object *unstructured.Unstructured) (*unstructure
 	namespace := object.GetNamespace()
 	gvr := v.groupVersionResourceFromUnstructured(object)
 
-	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{})
+	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{
+		DryRun:       v.dryRun(),
+		FieldManager: v.options.FieldManager,
+	})
+}
+
+// Apply creates or updates the resource with a server-side apply of the fields set in object.
+func (v *resourceVerber) Apply(object *unstructured.Unstructured, force bool) (*unstructured.Unstructured, error) {
+	namespace := object.GetNamespace()
+	gvr := v.groupVersionResourceFromUnstructured(object)
+
+	// managed fields are set by the server and must not be part of an apply configuration
+	applied := object.DeepCopy()
+	applied.SetManagedFields(nil)
+	return v.client.Resource(gvr).Namespace(namespace).Apply(context.TODO(), applied.GetName(), applied, metav1.ApplyOptions{
+		DryRun:       v.dryRun(),
+		Force:        force,
+		FieldManager: v.options.FieldManager,
+	})
 }
 
 // VerberClient returns a resourceVerber client.
-func VerberClient(_ *http.Request) (ResourceVerber, error) {
+func VerberClient(req *http.Request) (ResourceVerber, error) {
+	return VerberClientWithOptions(req, VerberOptions{FieldManager: DefaultFieldManager})
+}
+
+// VerberClientWithOptions returns a resourceVerber client that applies options to its mutating operations.
+func VerberClientWithOptions(_ *http.Request, options VerberOptions) (ResourceVerber, error) {
+	if options.FieldManager == "" {
+		options.FieldManager = DefaultFieldManager
+	}
 	// todo currently ignore rest.config from http.Request
 	restConfig, _, err := GetKarmadaConfig()
 	if err != nil {
@@ -214,8 +270,15 @@ func VerberClient(_ *http.Request) (ResourceVerber, error) {
 		return nil, err
 	}
 
+	return newResourceVerber(dynamicClient, discoveryClient, options), nil
+}
+
+// newResourceVerber returns a verber whose discovery is cached in memory for its lifetime, so that the kinds missing
+// from the shared cache, such as a kind that is not served, are discovered once per verber and not once per object.
+func newResourceVerber(dynamicClient dynamic.Interface, discoveryClient discovery.DiscoveryInterface, options VerberOptions) *resourceVerber {
 	return &resourceVerber{
 		client:    dynamicClient,
-		discovery: discoveryClient,
-	}, nil
+		discovery: memory.NewMemCacheClient(discoveryClient),
+		options:   options,
+	}
 }
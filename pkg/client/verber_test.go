@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func configMap(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+}
+
+// TestResourceVerberConcurrent uses verbers concurrently from a cold GroupVersionResource cache, as the bulk
+// actions do; run with -race to check the cache.
+func TestResourceVerberConcurrent(t *testing.T) {
+	kindToGroupVersionResourceMu.Lock()
+	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+	kindToGroupVersionResourceMu.Unlock()
+
+	const count = 20
+	objects := make([]runtime.Object, 0, count)
+	for i := 0; i < count; i++ {
+		objects = append(objects, configMap(fmt.Sprintf("settings-%d", i)))
+	}
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{configMaps: "ConfigMapList"}, objects...)
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+	}}}}
+
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			verber := newResourceVerber(dynamicClient, discoveryClient, VerberOptions{FieldManager: DefaultFieldManager})
+			name := fmt.Sprintf("settings-%d", i)
+			if _, err := verber.Get("configmap", "default", name); err != nil {
+				errs[i] = err
+				return
+			}
+			updated := configMap(name)
+			updated.SetLabels(map[string]string{"app": "web"})
+			errs[i] = verber.Update(updated)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("verber settings-%d failed: %v", i, err)
+		}
+	}
+	if gvr, exists := cachedGroupVersionResource("configmap"); !exists || gvr != configMaps {
+		t.Errorf("cachedGroupVersionResource(configmap) == %#v, %t expected %#v", gvr, exists, configMaps)
+	}
+}
+
+func TestResourceVerberUnservedKind(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+	}}}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{{Group: "example.com", Version: "v1", Resource: "widgets"}: "WidgetList"})
+	verber := newResourceVerber(dynamicClient, discoveryClient, VerberOptions{FieldManager: DefaultFieldManager})
+
+	widget := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+		}}
+	}
+	// the kind is not served, every create misses the shared cache
+	if _, err := verber.Create(widget("widget-0")); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	discovered := len(discoveryClient.Actions())
+	for i := 1; i < 5; i++ {
+		if _, err := verber.Create(widget(fmt.Sprintf("widget-%d", i))); err != nil {
+			t.Fatalf("Create() failed: %v", err)
+		}
+	}
+	if actions := len(discoveryClient.Actions()); discovered == 0 || actions != discovered {
+		t.Errorf("discovery has %d actions after 5 creates, expected the %d of the first create", actions, discovered)
+	}
+}
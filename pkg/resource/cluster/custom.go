@@ -26,6 +26,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog/v2"
 	"log"
+	"time"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
@@ -133,11 +134,11 @@ func toCustomCluster(cluster *v1alpha1.Cluster) CustomCluster {
 		TotalNum: cluster.Status.NodeSummary.TotalNum,
 	}
 
-	//requestUsage
-	requestUsage := v1.Usage{
+	//requestUsage (read from the karmada cluster status, as old as the status)
+	requestUsage := metrics.StampUsage(v1.Usage{
 		CPU:    common.RoundToTwoDecimals(allocatedResources.CPUFraction),
 		Memory: common.RoundToTwoDecimals(allocatedResources.MemoryFraction),
-	}
+	}, statusUpdatedAt(cluster))
 
 	return CustomCluster{
 		ClusterId:         clusterId,
@@ -152,6 +153,38 @@ func toCustomCluster(cluster *v1alpha1.Cluster) CustomCluster {
 	}
 }
 
+// statusUpdatedAt returns the last time the status of the cluster was written, or the zero time if unknown.
+// The cluster status has no heartbeat field, so it is the latest of the status write recorded in the managed
+// fields and the last transition of a status condition.
+func statusUpdatedAt(cluster *v1alpha1.Cluster) time.Time {
+	var updatedAt time.Time
+	for _, entry := range cluster.ManagedFields {
+		if entry.Subresource == "status" && entry.Time != nil && entry.Time.After(updatedAt) {
+			updatedAt = entry.Time.Time
+		}
+	}
+	for _, condition := range cluster.Status.Conditions {
+		if condition.LastTransitionTime.After(updatedAt) {
+			updatedAt = condition.LastTransitionTime.Time
+		}
+	}
+	return updatedAt
+}
+
+// GetClusterNameByID returns the name of the cluster annotated with the given cluster id.
+func GetClusterNameByID(client karmadaclientset.Interface, clusterID string) (string, error) {
+	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return "", err
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Annotations[AnnotationKeyClusterId] == clusterID {
+			return cluster.Name, nil
+		}
+	}
+	return "", apierrors.NewNotFound(v1alpha1.Resource("clusters"), clusterID)
+}
+
 // GetCustomClusterDetail gets details of cluster.
 func GetCustomClusterDetail(client karmadaclientset.Interface, clusterName string) (*v1alpha1.Cluster, error) {
 	log.Printf("Getting details of %s cluster", clusterName)
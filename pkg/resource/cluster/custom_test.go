@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStatusUpdatedAt(t *testing.T) {
+	start := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) *metav1.Time {
+		ts := metav1.NewTime(start.Add(time.Duration(minutes) * time.Minute))
+		return &ts
+	}
+	cases := []struct {
+		cluster  v1alpha1.Cluster
+		expected time.Time
+	}{
+		{v1alpha1.Cluster{}, time.Time{}},
+		{
+			v1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: "karmada-controller-manager", Subresource: "status", Time: at(5)},
+					// writes of the spec do not update the status
+					{Manager: "karmada-dashboard", Time: at(10)},
+				}},
+				Status: v1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", LastTransitionTime: *at(1)}}},
+			},
+			start.Add(5 * time.Minute),
+		},
+		{
+			v1alpha1.Cluster{
+				Status: v1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, LastTransitionTime: *at(3)}}},
+			},
+			start.Add(3 * time.Minute),
+		},
+	}
+	for _, c := range cases {
+		if actual := statusUpdatedAt(&c.cluster); !actual.Equal(c.expected) {
+			t.Errorf("statusUpdatedAt(%#v) == %s, expected %s", c.cluster, actual, c.expected)
+		}
+	}
+}
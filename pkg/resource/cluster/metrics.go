@@ -14,10 +14,14 @@ func setClusterRealTimeUsage(clusters []CustomCluster, metricsOpt *metrics.Clust
 		metricsData, _ = metrics.GetClustersRealTimeUsage()
 	}
 
+	// clusters keep v1.InitUsage(), which is marked stale and missing, if there is no metrics entry for them
 	if metricsData == nil {
 		klog.Warning("Cluster usage metrics are nil")
 		return
 	}
+	if metrics.IsStale(metricsData.Time.Time) {
+		klog.Warningf("Cluster usage metrics are stale, collected at %s", metricsData.Time.String())
+	}
 
 	memberStatusMap := make(map[string]metrics.Status)
 	for _, status := range metricsData.MemberClusterStatus {
@@ -27,9 +31,11 @@ func setClusterRealTimeUsage(clusters []CustomCluster, metricsOpt *metrics.Clust
 	// Match and update each cluster's RealTimeUsage
 	for i := range clusters {
 		cluster := &clusters[i]
-		if status, ok := memberStatusMap[cluster.ClusterId]; ok {
-			cluster.RealTimeUsage.CPU = status.RealTimeUsage.CPU
-			cluster.RealTimeUsage.Memory = status.RealTimeUsage.Memory
+		status, ok := memberStatusMap[cluster.ClusterId]
+		if !ok {
+			klog.Warningf("No usage metrics entry for cluster %s", cluster.Name)
+			continue
 		}
+		cluster.RealTimeUsage = metrics.StampUsage(status.RealTimeUsage, metricsData.Time.Time)
 	}
 }
@@ -34,9 +34,9 @@ import (
 
 const (
 	// CronJobAPIVersion is the version of the api for the cronjob.
-	CronJobAPIVersion = "v1"
+	CronJobAPIVersion = "batch/v1"
 	// CronJobKindName is the kind name of the api for the cronjob.
-	CronJobKindName = "cronjob"
+	CronJobKindName = "CronJob"
 )
 
 var emptyJobList = &job.JobList{
@@ -88,13 +88,20 @@ func GetCronJobJobs(client client.Interface,
 	return job.ToJobList(jobs.Items, pods.Items, events.Items, nonCriticalErrors, dsQuery), nil
 }
 
-// TriggerCronJob manually triggers a cron job and creates a new job.
+// TriggerCronJob manually triggers a cron job and returns the new job.
 func TriggerCronJob(client client.Interface,
-	namespace, name string) error {
+	namespace, name string) (*batch.Job, error) {
+	return TriggerCronJobWithOptions(client, namespace, name, meta.CreateOptions{})
+}
+
+// TriggerCronJobWithOptions manually triggers a cron job with the options of the job creation, such as a dry run,
+// and returns the new job.
+func TriggerCronJobWithOptions(client client.Interface,
+	namespace, name string, opts meta.CreateOptions) (*batch.Job, error) {
 	cronJob, err := client.BatchV1().CronJobs(namespace).Get(context.TODO(), name, meta.GetOptions{})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	annotations := make(map[string]string)
@@ -129,13 +136,7 @@ func TriggerCronJob(client client.Interface,
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
 
-	_, err = client.BatchV1().Jobs(namespace).Create(context.TODO(), jobToCreate, meta.CreateOptions{})
-
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return client.BatchV1().Jobs(namespace).Create(context.TODO(), jobToCreate, opts)
 }
 
 func filterJobsByOwnerUID(UID apimachinery.UID, jobs []batch.Job) (matchingJobs []batch.Job) {
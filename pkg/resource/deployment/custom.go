@@ -26,7 +26,6 @@ type CustomDeployment struct {
 	Namespace string            `json:"namespace"`
 	Name      string            `json:"name"`
 	Labels    map[string]string `json:"labels"`
-	policies  string            `json:"policy"`
 }
 
 func GetAppsV1DeploymentList(client client.Interface, nsQuery *common.NamespaceQuery) (*v1.DeploymentList, error) {